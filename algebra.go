@@ -0,0 +1,159 @@
+package slice
+
+// Difference returns the distinct values of slice a that are not in slice b, in the order they first appear in a.
+//
+//	a := &slice.Slice[int]{1, 2, 2, 3}
+//	b := &slice.Slice[int]{2, 4}
+//	fmt.Println(slice.Difference(a, b)) // &[1, 3]
+func Difference[T comparable](a *Slice[T], b *Slice[T]) *Slice[T] {
+	return DifferenceFunc(a, b, identity[T])
+}
+
+// DifferenceFunc is like Difference but compares the keys returned by the provided function.
+func DifferenceFunc[T any, K comparable](a *Slice[T], b *Slice[T], key func(value T) K) *Slice[T] {
+	excluded := keySet(b, key)
+	return distinctFunc(a, key, func(k K) bool {
+		_, ok := excluded[k]
+		return !ok
+	})
+}
+
+// Disjoint checks if slices a and b have no values in common.
+//
+//	a := &slice.Slice[int]{1, 2}
+//	b := &slice.Slice[int]{3, 4}
+//	fmt.Println(slice.Disjoint(a, b)) // true
+func Disjoint[T comparable](a *Slice[T], b *Slice[T]) bool {
+	return DisjointFunc(a, b, identity[T])
+}
+
+// DisjointFunc is like Disjoint but compares the keys returned by the provided function.
+func DisjointFunc[T any, K comparable](a *Slice[T], b *Slice[T], key func(value T) K) bool {
+	if len(*b) < len(*a) {
+		a, b = b, a // Hash the smaller slice.
+	}
+	keys := keySet(a, key)
+	for _, value := range *b {
+		if _, ok := keys[key(value)]; ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Intersect returns the distinct values of slice a that are also in slice b, in the order they first appear in a.
+//
+//	a := &slice.Slice[int]{3, 1, 2, 3}
+//	b := &slice.Slice[int]{2, 3, 4}
+//	fmt.Println(slice.Intersect(a, b)) // &[3, 2]
+func Intersect[T comparable](a *Slice[T], b *Slice[T]) *Slice[T] {
+	return IntersectFunc(a, b, identity[T])
+}
+
+// IntersectFunc is like Intersect but compares the keys returned by the provided function.
+func IntersectFunc[T any, K comparable](a *Slice[T], b *Slice[T], key func(value T) K) *Slice[T] {
+	included := keySet(b, key)
+	return distinctFunc(a, key, func(k K) bool {
+		_, ok := included[k]
+		return ok
+	})
+}
+
+// IsSubset checks if every value of slice a is also in slice b.
+//
+//	a := &slice.Slice[int]{1, 2}
+//	b := &slice.Slice[int]{1, 2, 3}
+//	fmt.Println(slice.IsSubset(a, b)) // true
+func IsSubset[T comparable](a *Slice[T], b *Slice[T]) bool {
+	return IsSubsetFunc(a, b, identity[T])
+}
+
+// IsSubsetFunc is like IsSubset but compares the keys returned by the provided function.
+func IsSubsetFunc[T any, K comparable](a *Slice[T], b *Slice[T], key func(value T) K) bool {
+	keys := keySet(b, key)
+	for _, value := range *a {
+		if _, ok := keys[key(value)]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset checks if every value of slice b is also in slice a.
+//
+//	a := &slice.Slice[int]{1, 2, 3}
+//	b := &slice.Slice[int]{1, 2}
+//	fmt.Println(slice.IsSuperset(a, b)) // true
+func IsSuperset[T comparable](a *Slice[T], b *Slice[T]) bool {
+	return IsSubset(b, a)
+}
+
+// IsSupersetFunc is like IsSuperset but compares the keys returned by the provided function.
+func IsSupersetFunc[T any, K comparable](a *Slice[T], b *Slice[T], key func(value T) K) bool {
+	return IsSubsetFunc(b, a, key)
+}
+
+// SymmetricDifference returns the distinct values that are in exactly one of slices a and b:
+// first those of a in the order they first appear in a, then those of b in the order they first appear in b.
+//
+//	a := &slice.Slice[int]{1, 2, 3}
+//	b := &slice.Slice[int]{4, 3, 2}
+//	fmt.Println(slice.SymmetricDifference(a, b)) // &[1, 4]
+func SymmetricDifference[T comparable](a *Slice[T], b *Slice[T]) *Slice[T] {
+	return SymmetricDifferenceFunc(a, b, identity[T])
+}
+
+// SymmetricDifferenceFunc is like SymmetricDifference but compares the keys returned by the provided function.
+func SymmetricDifferenceFunc[T any, K comparable](a *Slice[T], b *Slice[T], key func(value T) K) *Slice[T] {
+	return DifferenceFunc(a, b, key).Concatenate(DifferenceFunc(b, a, key))
+}
+
+// Union returns the distinct values of slices a and b in the order they first appear in a followed by b.
+//
+//	a := &slice.Slice[int]{1, 2, 2}
+//	b := &slice.Slice[int]{3, 1}
+//	fmt.Println(slice.Union(a, b)) // &[1, 2, 3]
+func Union[T comparable](a *Slice[T], b *Slice[T]) *Slice[T] {
+	return UnionFunc(a, b, identity[T])
+}
+
+// UnionFunc is like Union but compares the keys returned by the provided function.
+// The first value seen for each key is kept.
+func UnionFunc[T any, K comparable](a *Slice[T], b *Slice[T], key func(value T) K) *Slice[T] {
+	values := make(Slice[T], 0, len(*a)+len(*b))
+	values = append(append(values, *a...), *b...)
+	return distinctFunc(&values, key, func(K) bool {
+		return true
+	})
+}
+
+// distinctFunc returns the values of s whose keys satisfy keep, keeping only the first value seen for each key.
+func distinctFunc[T any, K comparable](s *Slice[T], key func(value T) K, keep func(k K) bool) *Slice[T] {
+	seen := make(map[K]struct{})
+	newSlice := Slice[T]{}
+	for _, value := range *s {
+		k := key(value)
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		if keep(k) {
+			newSlice = append(newSlice, value)
+		}
+	}
+	return &newSlice
+}
+
+// keySet returns the set of keys of the values of s.
+func keySet[T any, K comparable](s *Slice[T], key func(value T) K) map[K]struct{} {
+	keys := make(map[K]struct{}, len(*s))
+	for _, value := range *s {
+		keys[key(value)] = struct{}{}
+	}
+	return keys
+}
+
+// identity returns value unchanged. It is the key function of the comparable set operations.
+func identity[T comparable](value T) T {
+	return value
+}
@@ -0,0 +1,98 @@
+package slice_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/lindsaygelle/slice"
+)
+
+func TestUnion(t *testing.T) {
+	a, b := &slice.Slice[int]{1, 2, 2}, &slice.Slice[int]{3, 1, 4}
+
+	expected := &slice.Slice[int]{1, 2, 3, 4}
+	if result := slice.Union(a, b); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+	if result := slice.Union(&slice.Slice[int]{}, &slice.Slice[int]{}); !reflect.DeepEqual(result, &slice.Slice[int]{}) {
+		t.Errorf("Expected an empty slice, but got %v", result)
+	}
+}
+
+func TestIntersect(t *testing.T) {
+	a, b := &slice.Slice[int]{3, 1, 2, 3}, &slice.Slice[int]{2, 3, 4}
+
+	expected := &slice.Slice[int]{3, 2}
+	if result := slice.Intersect(a, b); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+}
+
+func TestDifference(t *testing.T) {
+	a, b := &slice.Slice[int]{1, 2, 1, 3}, &slice.Slice[int]{2, 4}
+
+	expected := &slice.Slice[int]{1, 3}
+	if result := slice.Difference(a, b); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+}
+
+func TestSymmetricDifference(t *testing.T) {
+	a, b := &slice.Slice[int]{1, 2, 3, 1}, &slice.Slice[int]{4, 3, 2, 5, 4}
+
+	expected := &slice.Slice[int]{1, 4, 5}
+	if result := slice.SymmetricDifference(a, b); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+}
+
+func TestSubsetSupersetDisjoint(t *testing.T) {
+	a, b, c := &slice.Slice[int]{1, 2}, &slice.Slice[int]{2, 1, 3}, &slice.Slice[int]{4}
+
+	if !slice.IsSubset(a, b) || slice.IsSubset(b, a) {
+		t.Errorf("Expected %v to be a subset of %v only", a, b)
+	}
+	if !slice.IsSuperset(b, a) || slice.IsSuperset(a, b) {
+		t.Errorf("Expected %v to be a superset of %v only", b, a)
+	}
+	if !slice.IsSubset(&slice.Slice[int]{}, a) {
+		t.Errorf("Expected the empty slice to be a subset")
+	}
+	if !slice.Disjoint(a, c) || slice.Disjoint(a, b) {
+		t.Errorf("Expected %v and %v to be disjoint only", a, c)
+	}
+}
+
+func TestSetAlgebraFunc(t *testing.T) {
+	// Test case: Non-comparable values compared by key, keeping the first value seen for each key.
+	type user struct {
+		Name string
+		Tags []string
+	}
+	key := func(value user) string {
+		return strings.ToLower(value.Name)
+	}
+	a := &slice.Slice[user]{{Name: "Ann"}, {Name: "Bob"}}
+	b := &slice.Slice[user]{{Name: "bob", Tags: []string{"x"}}, {Name: "Cy"}}
+
+	expected := &slice.Slice[user]{{Name: "Ann"}, {Name: "Bob"}, {Name: "Cy"}}
+	if result := slice.UnionFunc(a, b, key); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+	expected = &slice.Slice[user]{{Name: "Bob"}}
+	if result := slice.IntersectFunc(a, b, key); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+	expected = &slice.Slice[user]{{Name: "Ann"}}
+	if result := slice.DifferenceFunc(a, b, key); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+	expected = &slice.Slice[user]{{Name: "Ann"}, {Name: "Cy"}}
+	if result := slice.SymmetricDifferenceFunc(a, b, key); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+	if slice.DisjointFunc(a, b, key) || slice.IsSubsetFunc(a, b, key) || slice.IsSupersetFunc(a, b, key) {
+		t.Errorf("Expected false, but got true")
+	}
+}
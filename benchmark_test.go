@@ -584,3 +584,252 @@ func BenchmarkSwap(b *testing.B) {
 		slice.Swap(index1, index2)
 	}
 }
+
+func BenchmarkComparableContains(b *testing.B) {
+	slice := slice.NewComparable[int]()
+	for i := 0; i < 1000; i++ {
+		*slice = append(*slice, i)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = slice.Contains(i % 1000)
+	}
+}
+
+func BenchmarkComparableContainsReflect(b *testing.B) {
+	slice := &slice.Slice[int]{}
+	for i := 0; i < 1000; i++ {
+		slice.Append(i)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = slice.Contains(i % 1000)
+	}
+}
+
+func BenchmarkComparableContainsMany(b *testing.B) {
+	slice := slice.NewComparable[int]()
+	values := make([]int, 100)
+	for i := 0; i < 1000; i++ {
+		*slice = append(*slice, i)
+	}
+	for i := range values {
+		values[i] = i * 20
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = slice.ContainsMany(values...)
+	}
+}
+
+func BenchmarkComparableContainsManyReflect(b *testing.B) {
+	slice := &slice.Slice[int]{}
+	values := make([]int, 100)
+	for i := 0; i < 1000; i++ {
+		slice.Append(i)
+	}
+	for i := range values {
+		values[i] = i * 20
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = slice.ContainsMany(values...)
+	}
+}
+
+func BenchmarkComparableDeduplicate(b *testing.B) {
+	values := make([]int, 1000)
+	for i := range values {
+		values[i] = i % 100
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = slice.NewComparable[int](values...).Deduplicate()
+	}
+}
+
+func BenchmarkComparableDeduplicateReflect(b *testing.B) {
+	values := make([]int, 1000)
+	for i := range values {
+		values[i] = i % 100
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = slice.New[int](values...).Deduplicate()
+	}
+}
+
+func BenchmarkComparableEqual(b *testing.B) {
+	slice1 := slice.NewComparable[int]()
+	slice2 := slice.NewComparable[int]()
+	for i := 0; i < 1000; i++ {
+		*slice1 = append(*slice1, i)
+		*slice2 = append(*slice2, i)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = slice1.Equal(slice2)
+	}
+}
+
+func BenchmarkComparableEqualReflect(b *testing.B) {
+	slice1 := &slice.Slice[int]{}
+	slice2 := &slice.Slice[int]{}
+	for i := 0; i < 1000; i++ {
+		slice1.Append(i)
+		slice2.Append(i)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = slice1.Equal(slice2)
+	}
+}
+
+func BenchmarkOrderedInsert(b *testing.B) {
+	slice := slice.NewOrdered[int]()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		slice.Insert(rand.Intn(1000))
+	}
+}
+
+func BenchmarkOrderedSort(b *testing.B) {
+	slice := slice.NewOrdered[int]()
+	for i := 0; i < b.N; i++ {
+		*slice = append(*slice, rand.Intn(b.N))
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = slice.Sort()
+	}
+}
+
+func BenchmarkPipeline(b *testing.B) {
+	slice := &slice.Slice[int]{}
+	for i := 0; i < 1000; i++ {
+		slice.Append(i)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = slice.Pipeline().Filter(func(value int) bool {
+			return value%2 == 0
+		}).Map(func(value int) int {
+			return value * value
+		}).Collect()
+	}
+}
+
+func BenchmarkPipelineEager(b *testing.B) {
+	slice := &slice.Slice[int]{}
+	for i := 0; i < 1000; i++ {
+		slice.Append(i)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = slice.Filter(func(i int, value int) bool {
+			return value%2 == 0
+		}).Map(func(i int, value int) int {
+			return value * value
+		})
+	}
+}
+
+func BenchmarkParallelMap(b *testing.B) {
+	options := slice.ParallelOptions{}
+	slice := &slice.Slice[int]{}
+	for i := 0; i < 100000; i++ {
+		slice.Append(i)
+	}
+	fn := func(i int, value int) int {
+		return value * value
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = slice.ParallelMap(options, fn)
+	}
+}
+
+func BenchmarkDequePushFront(b *testing.B) {
+	deque := &slice.Deque[int]{}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		deque.PushFront(i)
+	}
+}
+
+func BenchmarkDequePushFrontPrepend(b *testing.B) {
+	slice := &slice.Slice[int]{}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		slice.Prepend(i)
+	}
+}
+
+func BenchmarkDequePopFront(b *testing.B) {
+	deque := &slice.Deque[int]{}
+	for i := 0; i < b.N; i++ {
+		deque.PushBack(i)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = deque.PopFront()
+	}
+}
+
+func BenchmarkDequePopFrontPoll(b *testing.B) {
+	slice := &slice.Slice[int]{}
+	for i := 0; i < b.N; i++ {
+		slice.Append(i)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = slice.Poll()
+	}
+}
+
+func BenchmarkRingAppend(b *testing.B) {
+	ring := slice.NewRing[int](1000)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		ring.Append(i)
+	}
+}
+
+func BenchmarkPriorityQueuePush(b *testing.B) {
+	queue := slice.NewMinHeap[int]()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		queue.Push(rand.Intn(b.N))
+	}
+}
+
+func BenchmarkPriorityQueuePopMin(b *testing.B) {
+	queue := slice.NewMinHeap[int]()
+	for i := 0; i < b.N; i++ {
+		queue.Push(rand.Intn(b.N))
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = queue.PopMin()
+	}
+}
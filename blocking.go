@@ -0,0 +1,233 @@
+package slice
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrClosed is returned by the blocking methods of BlockingSlice once it has been closed.
+var ErrClosed = errors.New("slice: closed")
+
+// BlockingSlice represents a generic slice with blocking queue semantics that is safe for concurrent use.
+// Consumers waiting in PollWait or PopWait sleep until a value is appended, the context is done or the slice is closed.
+// If the slice has a capacity, producers waiting in Append sleep until there is room, which provides backpressure.
+//
+// After Close, values that are already in the slice can still be taken; once it is empty the blocking
+// methods return ErrClosed instead of waiting.
+//
+// The zero value is an empty, unbounded slice ready to use. A BlockingSlice must not be copied after first use.
+type BlockingSlice[T any] struct {
+	mutex    sync.Mutex
+	slice    Slice[T]
+	capacity int
+	closed   bool
+	changed  chan struct{} // Closed and replaced whenever the slice changes.
+}
+
+// Append appends the given values to the slice, blocking while the slice is full, and returns the number of values appended.
+// It returns ErrClosed if the slice is closed before every value has been appended; see AppendWait.
+//
+//	queue := slice.NewBlocking[int](2)
+//	n, err := queue.Append(1, 2)
+//	fmt.Println(n, err) // 2, <nil>
+func (blockingSlice *BlockingSlice[T]) Append(values ...T) (int, error) {
+	return blockingSlice.AppendWait(context.Background(), values...)
+}
+
+// AppendWait appends the given values to the slice, blocking while the slice is full, and returns the number of values appended.
+// If the values do not fit at once they are appended in order as room becomes available.
+// It returns the context error if the context is done, or ErrClosed if the slice is closed,
+// before every value has been appended. The values before the returned count are in the slice
+// and may already have been taken by consumers, and the rest were not appended.
+//
+//	queue := slice.NewBlocking[int](2, 1)
+//	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+//	defer cancel()
+//	n, err := queue.AppendWait(ctx, 2, 3)
+//	fmt.Println(n, err) // 1, context deadline exceeded
+func (blockingSlice *BlockingSlice[T]) AppendWait(ctx context.Context, values ...T) (int, error) {
+	appended := 0
+	for {
+		blockingSlice.mutex.Lock()
+		if blockingSlice.closed {
+			blockingSlice.mutex.Unlock()
+			return appended, ErrClosed
+		}
+		n := len(values)
+		if blockingSlice.capacity > 0 {
+			n = min(n, blockingSlice.capacity-blockingSlice.slice.Length())
+		}
+		if n > 0 {
+			blockingSlice.slice.Append(values[:n]...)
+			values = values[n:]
+			appended += n
+			blockingSlice.notify()
+		}
+		if len(values) == 0 {
+			blockingSlice.mutex.Unlock()
+			return appended, nil
+		}
+		changed := blockingSlice.changedLocked()
+		blockingSlice.mutex.Unlock()
+		if err := wait(ctx, changed); err != nil {
+			return appended, err
+		}
+	}
+}
+
+// Capacity returns the maximum number of values the slice holds before Append blocks, or 0 if it is unbounded.
+func (blockingSlice *BlockingSlice[T]) Capacity() int {
+	return blockingSlice.capacity
+}
+
+// Close closes the slice, waking every blocked producer and consumer. Close is idempotent.
+//
+//	queue := slice.NewBlocking[int](0)
+//	queue.Close()
+//	_, err := queue.PollWait(context.Background())
+//	fmt.Println(err) // slice: closed
+func (blockingSlice *BlockingSlice[T]) Close() {
+	blockingSlice.mutex.Lock()
+	defer blockingSlice.mutex.Unlock()
+	if !blockingSlice.closed {
+		blockingSlice.closed = true
+		blockingSlice.notify()
+	}
+}
+
+// IsClosed returns true if the slice has been closed, or false otherwise.
+func (blockingSlice *BlockingSlice[T]) IsClosed() bool {
+	blockingSlice.mutex.Lock()
+	defer blockingSlice.mutex.Unlock()
+	return blockingSlice.closed
+}
+
+// Length returns the number of values in the slice.
+func (blockingSlice *BlockingSlice[T]) Length() int {
+	blockingSlice.mutex.Lock()
+	defer blockingSlice.mutex.Unlock()
+	return blockingSlice.slice.Length()
+}
+
+// PollOK removes and returns the first value of the slice and true without blocking, or a zero value and false if the slice is empty.
+func (blockingSlice *BlockingSlice[T]) PollOK() (T, bool) {
+	return blockingSlice.take((*Slice[T]).PollOK)
+}
+
+// PollTimeout removes and returns the first value of the slice, waiting up to the given duration for one to be appended.
+// It returns context.DeadlineExceeded if the duration elapses, or ErrClosed if the slice is closed and empty.
+//
+//	queue := slice.NewBlocking[int](0)
+//	_, err := queue.PollTimeout(10 * time.Millisecond)
+//	fmt.Println(err) // context deadline exceeded
+func (blockingSlice *BlockingSlice[T]) PollTimeout(d time.Duration) (T, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), d)
+	defer cancel()
+	return blockingSlice.PollWait(ctx)
+}
+
+// PollWait removes and returns the first value of the slice, blocking until one is appended.
+// It returns the context error if the context is done, or ErrClosed if the slice is closed and empty.
+//
+//	queue := slice.NewBlocking[int](0)
+//	go queue.Append(1)
+//	value, err := queue.PollWait(context.Background())
+//	fmt.Println(value, err) // 1, <nil>
+func (blockingSlice *BlockingSlice[T]) PollWait(ctx context.Context) (T, error) {
+	return blockingSlice.takeWait(ctx, (*Slice[T]).PollOK)
+}
+
+// PopOK removes and returns the last value of the slice and true without blocking, or a zero value and false if the slice is empty.
+func (blockingSlice *BlockingSlice[T]) PopOK() (T, bool) {
+	return blockingSlice.take((*Slice[T]).PopOK)
+}
+
+// PopTimeout removes and returns the last value of the slice, waiting up to the given duration for one to be appended.
+// It returns context.DeadlineExceeded if the duration elapses, or ErrClosed if the slice is closed and empty.
+func (blockingSlice *BlockingSlice[T]) PopTimeout(d time.Duration) (T, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), d)
+	defer cancel()
+	return blockingSlice.PopWait(ctx)
+}
+
+// PopWait removes and returns the last value of the slice, blocking until one is appended.
+// It returns the context error if the context is done, or ErrClosed if the slice is closed and empty.
+func (blockingSlice *BlockingSlice[T]) PopWait(ctx context.Context) (T, error) {
+	return blockingSlice.takeWait(ctx, (*Slice[T]).PopOK)
+}
+
+// Snapshot returns a copy of the values in the slice.
+func (blockingSlice *BlockingSlice[T]) Snapshot() *Slice[T] {
+	blockingSlice.mutex.Lock()
+	defer blockingSlice.mutex.Unlock()
+	return New(blockingSlice.slice...)
+}
+
+// changedLocked returns the channel that is closed on the next change. The mutex must be held.
+func (blockingSlice *BlockingSlice[T]) changedLocked() chan struct{} {
+	if blockingSlice.changed == nil {
+		blockingSlice.changed = make(chan struct{})
+	}
+	return blockingSlice.changed
+}
+
+// notify wakes every goroutine waiting for a change. The mutex must be held.
+func (blockingSlice *BlockingSlice[T]) notify() {
+	if blockingSlice.changed != nil {
+		close(blockingSlice.changed)
+		blockingSlice.changed = nil
+	}
+}
+
+// take removes a value from the slice with the given function without blocking.
+func (blockingSlice *BlockingSlice[T]) take(fn func(slice *Slice[T]) (T, bool)) (T, bool) {
+	blockingSlice.mutex.Lock()
+	defer blockingSlice.mutex.Unlock()
+	value, ok := fn(&blockingSlice.slice)
+	if ok {
+		blockingSlice.notify()
+	}
+	return value, ok
+}
+
+// takeWait removes a value from the slice with the given function, blocking until one is available.
+func (blockingSlice *BlockingSlice[T]) takeWait(ctx context.Context, fn func(slice *Slice[T]) (T, bool)) (T, error) {
+	for {
+		blockingSlice.mutex.Lock()
+		value, ok := fn(&blockingSlice.slice)
+		if ok {
+			blockingSlice.notify()
+			blockingSlice.mutex.Unlock()
+			return value, nil
+		}
+		if blockingSlice.closed {
+			blockingSlice.mutex.Unlock()
+			return value, ErrClosed
+		}
+		changed := blockingSlice.changedLocked()
+		blockingSlice.mutex.Unlock()
+		if err := wait(ctx, changed); err != nil {
+			return value, err
+		}
+	}
+}
+
+// NewBlocking creates a new instance of the BlockingSlice[T] type with the given capacity and initializes it with the provided values.
+// A capacity of 0 or less makes the slice unbounded. Values beyond the capacity are still added.
+func NewBlocking[T any](capacity int, values ...T) *BlockingSlice[T] {
+	blockingSlice := &BlockingSlice[T]{capacity: max(capacity, 0)}
+	blockingSlice.slice.Append(values...)
+	return blockingSlice
+}
+
+// wait blocks until the given channel is closed or the context is done, and returns the context error in the latter case.
+func wait(ctx context.Context, changed <-chan struct{}) error {
+	select {
+	case <-changed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,187 @@
+package slice_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lindsaygelle/slice"
+)
+
+func TestBlockingAppend(t *testing.T) {
+	// Test case 1: Block while the slice is full until a consumer makes room.
+	s := slice.NewBlocking[int](2, 1, 2)
+	done := make(chan error)
+	go func() {
+		_, err := s.Append(3)
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		t.Fatalf("Expected Append to block, but it returned %v", err)
+	case <-time.After(20 * time.Millisecond):
+	}
+	if value, ok := s.PollOK(); !ok || value != 1 {
+		t.Errorf("Expected 1 and true, but got %d and %v", value, ok)
+	}
+	if err := <-done; err != nil {
+		t.Errorf("Expected no error, but got %v", err)
+	}
+
+	expected := &slice.Slice[int]{2, 3}
+	if result := s.Snapshot(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+
+	// Test case 2: Stop waiting when the context is done.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if n, err := s.AppendWait(ctx, 4); n != 0 || !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected 0 and %v, but got %d and %v", context.DeadlineExceeded, n, err)
+	}
+
+	// Test case 3: Report how many values were appended before the context was done.
+	s = slice.NewBlocking[int](3, 1)
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if n, err := s.AppendWait(ctx, 2, 3, 4, 5); n != 2 || !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected 2 and %v, but got %d and %v", context.DeadlineExceeded, n, err)
+	}
+	expected = &slice.Slice[int]{1, 2, 3}
+	if result := s.Snapshot(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+
+	// Test case 4: Report how many values were appended before the slice was closed.
+	s = slice.NewBlocking[int](2)
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		s.PollOK()
+		time.Sleep(10 * time.Millisecond)
+		s.Close()
+	}()
+	if n, err := s.Append(1, 2, 3, 4); n != 3 || !errors.Is(err, slice.ErrClosed) {
+		t.Errorf("Expected 3 and %v, but got %d and %v", slice.ErrClosed, n, err)
+	}
+}
+
+func TestBlockingClose(t *testing.T) {
+	// Test case 1: Wake blocked consumers with an error.
+	s := slice.NewBlocking[int](0)
+	var waitGroup sync.WaitGroup
+	errs := make([]error, 4)
+	for i := range errs {
+		waitGroup.Add(1)
+		go func(i int) {
+			defer waitGroup.Done()
+			if i%2 == 0 {
+				_, errs[i] = s.PollWait(context.Background())
+			} else {
+				_, errs[i] = s.PopWait(context.Background())
+			}
+		}(i)
+	}
+	time.Sleep(10 * time.Millisecond)
+	s.Close()
+	waitGroup.Wait()
+	for _, err := range errs {
+		if !errors.Is(err, slice.ErrClosed) {
+			t.Errorf("Expected %v, but got %v", slice.ErrClosed, err)
+		}
+	}
+
+	// Test case 2: Drain the remaining values after closing, then report the error.
+	s = slice.NewBlocking[int](0, 1)
+	s.Close()
+	if n, err := s.Append(2); n != 0 || !errors.Is(err, slice.ErrClosed) {
+		t.Errorf("Expected 0 and %v, but got %d and %v", slice.ErrClosed, n, err)
+	}
+	if value, err := s.PollWait(context.Background()); err != nil || value != 1 {
+		t.Errorf("Expected 1 and no error, but got %d and %v", value, err)
+	}
+	if _, err := s.PollWait(context.Background()); !errors.Is(err, slice.ErrClosed) {
+		t.Errorf("Expected %v, but got %v", slice.ErrClosed, err)
+	}
+	if !s.IsClosed() {
+		t.Errorf("Expected true, but got false")
+	}
+}
+
+func TestBlockingPollWait(t *testing.T) {
+	// Test case 1: Wait for a value to be appended.
+	s := &slice.BlockingSlice[int]{}
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		s.Append(1)
+	}()
+	if value, err := s.PollWait(context.Background()); err != nil || value != 1 {
+		t.Errorf("Expected 1 and no error, but got %d and %v", value, err)
+	}
+
+	// Test case 2: Give up after a timeout.
+	if _, err := s.PollTimeout(10 * time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected %v, but got %v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestBlockingPopWait(t *testing.T) {
+	// Test case 1: Take the last value.
+	s := slice.NewBlocking[int](0, 1, 2, 3)
+	if value, err := s.PopWait(context.Background()); err != nil || value != 3 {
+		t.Errorf("Expected 3 and no error, but got %d and %v", value, err)
+	}
+
+	// Test case 2: Give up after a timeout.
+	s = slice.NewBlocking[int](0)
+	if _, err := s.PopTimeout(10 * time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected %v, but got %v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestBlockingProducersAndConsumers(t *testing.T) {
+	// Test case: Move every value through a bounded slice exactly once.
+	s := slice.NewBlocking[int](8)
+	seen := make([]int, 4000)
+	var mutex sync.Mutex
+	var producers, consumers sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		producers.Add(1)
+		go func(i int) {
+			defer producers.Done()
+			for j := 0; j < 1000; j++ {
+				if _, err := s.Append(i*1000 + j); err != nil {
+					t.Errorf("Expected no error, but got %v", err)
+				}
+				if s.Length() > s.Capacity() {
+					t.Errorf("Expected length at most %d, but got %d", s.Capacity(), s.Length())
+				}
+			}
+		}(i)
+	}
+	for i := 0; i < 4; i++ {
+		consumers.Add(1)
+		go func() {
+			defer consumers.Done()
+			for {
+				value, err := s.PollWait(context.Background())
+				if err != nil {
+					return
+				}
+				mutex.Lock()
+				seen[value]++
+				mutex.Unlock()
+			}
+		}()
+	}
+	producers.Wait()
+	s.Close()
+	consumers.Wait()
+	for value, count := range seen {
+		if count != 1 {
+			t.Errorf("Expected value %d to be seen once, but got %d", value, count)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package slice
+
+// ComparableSlice represents a generic slice of comparable data types.
+// It shares its underlying type with Slice[T], so a *Slice[T] can be converted to a *ComparableSlice[T] (and back) without copying.
+// Methods that compare elements use == and map lookups instead of reflect.DeepEqual and fmt formatting.
+type ComparableSlice[T comparable] Slice[T]
+
+// Contains checks if the slice contains the given value.
+//
+//	newSlice := slice.NewComparable[int](1, 2, 3)
+//	contains := newSlice.Contains(2)
+//	fmt.Println(contains) // true
+func (slice *ComparableSlice[T]) Contains(value T) bool {
+	_, ok := slice.IndexOf(value)
+	return ok
+}
+
+// ContainsMany checks if the slice contains any of the given values and returns a boolean slice indicating the results.
+//
+//	newSlice := slice.NewComparable[int](1, 2, 3, 4, 5)
+//	contains := newSlice.ContainsMany(2, 6)
+//	fmt.Println(contains) // &[true, false]
+func (slice *ComparableSlice[T]) ContainsMany(values ...T) *Slice[bool] {
+	uniqueValues := make(map[T]struct{}, len(*slice))
+	for _, value := range *slice {
+		uniqueValues[value] = struct{}{}
+	}
+	newSlice := make(Slice[bool], len(values))
+	for i, value := range values {
+		_, newSlice[i] = uniqueValues[value]
+	}
+	return &newSlice
+}
+
+// Count returns the number of elements in the slice that are equal to the given value.
+//
+//	newSlice := slice.NewComparable[int](1, 2, 2, 3, 2)
+//	count := newSlice.Count(2)
+//	fmt.Println(count) // 3
+func (slice *ComparableSlice[T]) Count(value T) int {
+	var count int
+	for _, v := range *slice {
+		if v == value {
+			count++
+		}
+	}
+	return count
+}
+
+// Deduplicate removes duplicate values from the slice, keeping the first occurrence of each value, and returns the modified slice.
+//
+//	newSlice := slice.NewComparable[int](1, 2, 2, 3, 4, 4, 5)
+//	newSlice.Deduplicate()
+//	fmt.Println(newSlice) // &[1, 2, 3, 4, 5]
+func (slice *ComparableSlice[T]) Deduplicate() *ComparableSlice[T] {
+	uniqueValues := make(map[T]struct{}, len(*slice))
+	uniqueSlice := make(ComparableSlice[T], 0, len(*slice))
+	for _, value := range *slice {
+		if _, ok := uniqueValues[value]; !ok {
+			uniqueValues[value] = struct{}{}
+			uniqueSlice = append(uniqueSlice, value)
+		}
+	}
+	*slice = uniqueSlice
+	return slice
+}
+
+// Equal checks if the slice is equal to another slice based on the element values.
+//
+//	slice1 := slice.NewComparable[int](1, 2, 3)
+//	slice2 := slice.NewComparable[int](1, 2, 3)
+//	isEqual := slice1.Equal(slice2)
+//	fmt.Println(isEqual) // true
+func (slice *ComparableSlice[T]) Equal(otherSlice *ComparableSlice[T]) bool {
+	if otherSlice == nil || len(*slice) != len(*otherSlice) {
+		return false
+	}
+	for i, value := range *slice {
+		if value != (*otherSlice)[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// IndexOf returns the index of the first element equal to the given value and true,
+// or -1 and false if no such element is found.
+//
+//	newSlice := slice.NewComparable[int](1, 2, 3, 2)
+//	index, found := newSlice.IndexOf(2)
+//	fmt.Println(index, found) // 1, true
+func (slice *ComparableSlice[T]) IndexOf(value T) (int, bool) {
+	for i, v := range *slice {
+		if v == value {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+// LastIndexOf returns the index of the last element equal to the given value and true,
+// or -1 and false if no such element is found.
+//
+//	newSlice := slice.NewComparable[int](1, 2, 3, 2)
+//	index, found := newSlice.LastIndexOf(2)
+//	fmt.Println(index, found) // 3, true
+func (slice *ComparableSlice[T]) LastIndexOf(value T) (int, bool) {
+	for i := len(*slice) - 1; i >= 0; i-- {
+		if (*slice)[i] == value {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+// ToSlice returns the slice as a *Slice[T]. The returned slice shares memory with the receiver.
+//
+//	newSlice := slice.NewComparable[int](1, 2, 3)
+//	fmt.Println(newSlice.ToSlice().Length()) // 3
+func (slice *ComparableSlice[T]) ToSlice() *Slice[T] {
+	return (*Slice[T])(slice)
+}
+
+// AsComparable returns the given slice as a *ComparableSlice[T]. The returned slice shares memory with the argument,
+// so changes made through either value are visible through the other.
+//
+//	newSlice := slice.New[int](1, 2, 2, 3)
+//	slice.AsComparable(newSlice).Deduplicate()
+//	fmt.Println(newSlice) // &[1, 2, 3]
+func AsComparable[T comparable](slice *Slice[T]) *ComparableSlice[T] {
+	return (*ComparableSlice[T])(slice)
+}
+
+// NewComparable creates a new instance of the ComparableSlice[T] type and initializes it with the provided values.
+func NewComparable[T comparable](values ...T) *ComparableSlice[T] {
+	return AsComparable(New(values...))
+}
@@ -0,0 +1,136 @@
+package slice_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/lindsaygelle/slice"
+)
+
+func TestComparableContains(t *testing.T) {
+	// Test case 1: Check for a value that exists in the slice.
+	s := slice.NewComparable[int](1, 2, 3)
+	if !s.Contains(2) {
+		t.Errorf("Expected true, but got false")
+	}
+
+	// Test case 2: Check for a value that does not exist in the slice.
+	if s.Contains(4) {
+		t.Errorf("Expected false, but got true")
+	}
+
+	// Test case 3: Distinguish pointers that print the same.
+	a, b := 1, 1
+	p := slice.NewComparable[*int](&a)
+	if p.Contains(&b) {
+		t.Errorf("Expected false, but got true")
+	}
+}
+
+func TestComparableContainsMany(t *testing.T) {
+	// Test case: Check for multiple values in the slice.
+	s := slice.NewComparable[int](1, 2, 3, 4, 5)
+	result := s.ContainsMany(2, 6, 5)
+
+	expected := &slice.Slice[bool]{true, false, true}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+}
+
+func TestComparableCount(t *testing.T) {
+	// Test case 1: Count a value that occurs several times.
+	s := slice.NewComparable[int](1, 2, 2, 3, 2)
+	if count := s.Count(2); count != 3 {
+		t.Errorf("Expected count 3, but got %d", count)
+	}
+
+	// Test case 2: Count a value that does not occur.
+	if count := s.Count(4); count != 0 {
+		t.Errorf("Expected count 0, but got %d", count)
+	}
+}
+
+func TestComparableDeduplicate(t *testing.T) {
+	// Test case 1: Remove duplicates keeping the first occurrence.
+	s := slice.NewComparable[int](1, 2, 2, 3, 1, 4, 4, 5)
+	s.Deduplicate()
+
+	expected := slice.NewComparable[int](1, 2, 3, 4, 5)
+	if !reflect.DeepEqual(s, expected) {
+		t.Errorf("Expected %v, but got %v", expected, s)
+	}
+
+	// Test case 2: Keep pointers that print the same but differ.
+	a, b := 1, 1
+	p := slice.NewComparable[*int](&a, &b, &a)
+	p.Deduplicate()
+	if length := len(*p); length != 2 {
+		t.Errorf("Expected length 2, but got %d", length)
+	}
+}
+
+func TestComparableEqual(t *testing.T) {
+	// Test case 1: Compare equal slices.
+	s1 := slice.NewComparable[int](1, 2, 3)
+	s2 := slice.NewComparable[int](1, 2, 3)
+	if !s1.Equal(s2) {
+		t.Errorf("Expected true, but got false")
+	}
+
+	// Test case 2: Compare slices with different values.
+	s2 = slice.NewComparable[int](1, 2, 4)
+	if s1.Equal(s2) {
+		t.Errorf("Expected false, but got true")
+	}
+
+	// Test case 3: Compare slices with different lengths.
+	s2 = slice.NewComparable[int](1, 2)
+	if s1.Equal(s2) {
+		t.Errorf("Expected false, but got true")
+	}
+}
+
+func TestComparableIndexOf(t *testing.T) {
+	// Test case 1: Find the first index of a value.
+	s := slice.NewComparable[int](1, 2, 3, 2)
+	index, ok := s.IndexOf(2)
+	if !ok || index != 1 {
+		t.Errorf("Expected 1 and true, but got %d and %v", index, ok)
+	}
+
+	// Test case 2: Find a value that does not exist.
+	index, ok = s.IndexOf(4)
+	if ok || index != -1 {
+		t.Errorf("Expected -1 and false, but got %d and %v", index, ok)
+	}
+}
+
+func TestComparableLastIndexOf(t *testing.T) {
+	// Test case 1: Find the last index of a value.
+	s := slice.NewComparable[int](1, 2, 3, 2)
+	index, ok := s.LastIndexOf(2)
+	if !ok || index != 3 {
+		t.Errorf("Expected 3 and true, but got %d and %v", index, ok)
+	}
+
+	// Test case 2: Find a value that does not exist.
+	index, ok = s.LastIndexOf(4)
+	if ok || index != -1 {
+		t.Errorf("Expected -1 and false, but got %d and %v", index, ok)
+	}
+}
+
+func TestAsComparable(t *testing.T) {
+	// Test case: Changes made through the comparable view are visible in the original slice.
+	s := slice.New[int](1, 2, 2, 3)
+	slice.AsComparable(s).Deduplicate()
+
+	expected := &slice.Slice[int]{1, 2, 3}
+	if !reflect.DeepEqual(s, expected) {
+		t.Errorf("Expected %v, but got %v", expected, s)
+	}
+	if result := slice.AsComparable(s).ToSlice(); result != s {
+		t.Errorf("Expected result to be the same slice, but got a different slice")
+	}
+}
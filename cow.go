@@ -0,0 +1,383 @@
+package slice
+
+import (
+	"iter"
+	"sync"
+	"sync/atomic"
+)
+
+// COWSlice represents a generic copy-on-write slice that can be shared with many readers.
+// The current values are published through an atomic pointer as a header over a backing array, and a
+// write never changes values that have been published. Snapshot returns an immutable view of the current
+// values in O(1) without copying them.
+//
+// Append and Concatenate write into the spare capacity of the backing array, past every value a header
+// has published, and publish a longer header, so they cost amortized O(1) like the built-in append.
+// Pop and Poll publish a shorter header over the same array in O(1). The other writes change values that
+// a reader may be using at any moment, so they copy the values, change the copy and publish it in O(n).
+//
+// Each backing array counts the snapshots and reads that still use it. A copy goes into the array
+// replaced by the previous copy when that count is zero, and into a new array otherwise, so a COWSlice
+// that is written without outstanding snapshots does not allocate. Once the count is zero, Append may
+// also reuse the slots that Pop removed.
+//
+// Reads never take a lock and never block, even while a writer is copying. Writers are serialized by
+// a mutex. Functions passed to Do are called while that mutex is held and must not write to the same
+// COWSlice.
+//
+// The zero value is an empty slice ready to use. A COWSlice must not be copied after first use.
+type COWSlice[T any] struct {
+	mutex  sync.Mutex
+	header atomic.Pointer[cowHeader[T]]
+	spare  *cowBuffer[T] // The buffer replaced by the last copy, whose array may be reused.
+}
+
+// Append is the copy-on-write equivalent of Slice.Append.
+//
+//	newSlice := slice.NewCOW[int](1)
+//	newSlice.Append(2, 3)
+//	fmt.Println(newSlice.ToSlice()) // &[1, 2, 3]
+func (cowSlice *COWSlice[T]) Append(values ...T) *COWSlice[T] {
+	cowSlice.mutex.Lock()
+	defer cowSlice.mutex.Unlock()
+	cowSlice.append(values)
+	return cowSlice
+}
+
+// Clone returns a new COWSlice that shares the backing array of the slice in O(1).
+// The slices only append to the array past the values both have published, and never copy into it,
+// so their writes cannot affect each other.
+func (cowSlice *COWSlice[T]) Clone() *COWSlice[T] {
+	newSlice := &COWSlice[T]{}
+	if header := cowSlice.acquire(); header != nil {
+		newSlice.header.Store(header) // The reference is never released.
+	}
+	return newSlice
+}
+
+// Concatenate is the copy-on-write equivalent of Slice.Concatenate.
+func (cowSlice *COWSlice[T]) Concatenate(otherSlice *Slice[T]) *COWSlice[T] {
+	if otherSlice == nil {
+		return cowSlice
+	}
+	cowSlice.mutex.Lock()
+	defer cowSlice.mutex.Unlock()
+	cowSlice.append(*otherSlice)
+	return cowSlice
+}
+
+// Delete is the copy-on-write equivalent of Slice.Delete.
+func (cowSlice *COWSlice[T]) Delete(i int) *COWSlice[T] {
+	return cowSlice.Do(func(slice *Slice[T]) {
+		slice.Delete(i)
+	})
+}
+
+// Do runs the given function on a copy of the slice and publishes the result, then returns the COWSlice.
+// Writers are serialized, so the steps of fn are applied atomically. Readers see the values from before
+// or after fn, never in between. The *Slice[T] passed to fn must not be retained after fn returns.
+// Do always costs O(n); prefer Append, Concatenate, Pop and Poll for those changes.
+//
+//	newSlice := slice.NewCOW[int](3, 1, 2)
+//	newSlice.Do(func(s *slice.Slice[int]) {
+//	    s.Append(0).Reverse()
+//	})
+//	fmt.Println(newSlice.ToSlice()) // &[0, 2, 1, 3]
+func (cowSlice *COWSlice[T]) Do(fn func(slice *Slice[T])) *COWSlice[T] {
+	cowSlice.mutex.Lock()
+	defer cowSlice.mutex.Unlock()
+	header := cowSlice.header.Load()
+	values := Slice[T]{}
+	if spare := cowSlice.spare; spare != nil && spare.refs.Load() == 0 {
+		values = spare.values[:0] // No reader can reach the spare array any more.
+	}
+	if header != nil {
+		values = append(values, header.values...)
+	}
+	fn(&values)
+	if header != nil {
+		cowSlice.spare = header.buffer
+	}
+	cowSlice.header.Store(newCOWHeader(values))
+	return cowSlice
+}
+
+// Each applies the given function to each element of the slice and returns the COWSlice.
+// It iterates over a snapshot, so fn runs without holding a lock and may write to the COWSlice.
+func (cowSlice *COWSlice[T]) Each(fn func(i int, value T)) *COWSlice[T] {
+	snapshot := cowSlice.Snapshot()
+	defer snapshot.Release()
+	snapshot.Each(fn)
+	return cowSlice
+}
+
+// Fetch is the copy-on-write equivalent of Slice.Fetch.
+func (cowSlice *COWSlice[T]) Fetch(i int) T {
+	value, _ := cowSlice.Get(i)
+	return value
+}
+
+// Get is the copy-on-write equivalent of Slice.Get.
+func (cowSlice *COWSlice[T]) Get(i int) (T, bool) {
+	header := cowSlice.acquire()
+	if header == nil {
+		var value T
+		return value, false
+	}
+	defer header.buffer.refs.Add(-1)
+	return header.values.Get(i)
+}
+
+// IsEmpty is the copy-on-write equivalent of Slice.IsEmpty.
+func (cowSlice *COWSlice[T]) IsEmpty() bool {
+	return cowSlice.Length() == 0
+}
+
+// Length is the copy-on-write equivalent of Slice.Length.
+func (cowSlice *COWSlice[T]) Length() int {
+	header := cowSlice.acquire()
+	if header == nil {
+		return 0
+	}
+	defer header.buffer.refs.Add(-1)
+	return header.values.Length()
+}
+
+// Poll is the copy-on-write equivalent of Slice.Poll.
+func (cowSlice *COWSlice[T]) Poll() T {
+	value, _ := cowSlice.PollOK()
+	return value
+}
+
+// PollOK is the copy-on-write equivalent of Slice.PollOK.
+func (cowSlice *COWSlice[T]) PollOK() (T, bool) {
+	cowSlice.mutex.Lock()
+	defer cowSlice.mutex.Unlock()
+	header := cowSlice.header.Load()
+	if header == nil || len(header.values) == 0 {
+		var value T
+		return value, false
+	}
+	cowSlice.header.Store(&cowHeader[T]{buffer: header.buffer, values: header.values[1:], end: header.end})
+	return header.values[0], true
+}
+
+// Pop is the copy-on-write equivalent of Slice.Pop.
+func (cowSlice *COWSlice[T]) Pop() T {
+	value, _ := cowSlice.PopOK()
+	return value
+}
+
+// PopOK is the copy-on-write equivalent of Slice.PopOK.
+func (cowSlice *COWSlice[T]) PopOK() (T, bool) {
+	cowSlice.mutex.Lock()
+	defer cowSlice.mutex.Unlock()
+	header := cowSlice.header.Load()
+	if header == nil || len(header.values) == 0 {
+		var value T
+		return value, false
+	}
+	last := len(header.values) - 1
+	cowSlice.header.Store(&cowHeader[T]{buffer: header.buffer, values: header.values[:last], end: header.end - 1})
+	return header.values[last], true
+}
+
+// Prepend is the copy-on-write equivalent of Slice.Prepend.
+func (cowSlice *COWSlice[T]) Prepend(values ...T) *COWSlice[T] {
+	return cowSlice.Do(func(slice *Slice[T]) {
+		slice.Prepend(values...)
+	})
+}
+
+// Replace is the copy-on-write equivalent of Slice.Replace.
+func (cowSlice *COWSlice[T]) Replace(i int, value T) bool {
+	var ok bool
+	cowSlice.Do(func(slice *Slice[T]) {
+		ok = slice.Replace(i, value)
+	})
+	return ok
+}
+
+// Reverse is the copy-on-write equivalent of Slice.Reverse.
+func (cowSlice *COWSlice[T]) Reverse() *COWSlice[T] {
+	return cowSlice.Do(func(slice *Slice[T]) {
+		slice.Reverse()
+	})
+}
+
+// Snapshot returns an immutable view of the current values of the slice in O(1).
+// Later writes to the COWSlice are not visible through the snapshot. Call Release on the
+// snapshot once it is no longer needed so the next write can reuse the backing array.
+//
+//	newSlice := slice.NewCOW[int](1, 2, 3)
+//	snapshot := newSlice.Snapshot()
+//	defer snapshot.Release()
+//	newSlice.Replace(0, 10)
+//	fmt.Println(snapshot.ToSlice(), newSlice.ToSlice()) // &[1, 2, 3], &[10, 2, 3]
+func (cowSlice *COWSlice[T]) Snapshot() *COWSnapshot[T] {
+	header := cowSlice.acquire()
+	if header == nil {
+		return &COWSnapshot[T]{}
+	}
+	return &COWSnapshot[T]{buffer: header.buffer, values: header.values}
+}
+
+// SortFunc is the copy-on-write equivalent of Slice.SortFunc.
+func (cowSlice *COWSlice[T]) SortFunc(fn func(i int, j int, a T, b T) bool) *COWSlice[T] {
+	return cowSlice.Do(func(slice *Slice[T]) {
+		slice.SortFunc(fn)
+	})
+}
+
+// Swap is the copy-on-write equivalent of Slice.Swap.
+func (cowSlice *COWSlice[T]) Swap(i int, j int) {
+	cowSlice.Do(func(slice *Slice[T]) {
+		slice.Swap(i, j)
+	})
+}
+
+// ToSlice returns a new slice containing a copy of the elements of the slice.
+func (cowSlice *COWSlice[T]) ToSlice() *Slice[T] {
+	snapshot := cowSlice.Snapshot()
+	defer snapshot.Release()
+	return snapshot.ToSlice()
+}
+
+// acquire returns the current header with the reference count of its buffer incremented, or nil if the slice
+// has no header. The count is checked against the published pointer, so a writer never reuses an array while it is read.
+func (cowSlice *COWSlice[T]) acquire() *cowHeader[T] {
+	for {
+		header := cowSlice.header.Load()
+		if header == nil {
+			return nil
+		}
+		header.buffer.refs.Add(1)
+		if cowSlice.header.Load() == header {
+			return header
+		}
+		header.buffer.refs.Add(-1) // A writer replaced the header in between; the array past it may be reused.
+	}
+}
+
+// append adds values after the published ones. It writes into the spare capacity of the backing array
+// when the header can claim it, and otherwise copies into a larger array, so its cost is amortized O(1).
+// The caller must hold the mutex.
+func (cowSlice *COWSlice[T]) append(values []T) {
+	header := cowSlice.header.Load()
+	if header == nil {
+		cowSlice.header.Store(newCOWHeader(append(Slice[T]{}, values...)))
+		return
+	}
+	if header.claim(len(values)) {
+		newValues := append(header.values, values...) // Fits in the capacity, so the array is shared.
+		cowSlice.header.Store(&cowHeader[T]{buffer: header.buffer, values: newValues, end: header.end + len(values)})
+		return
+	}
+	cowSlice.header.Store(newCOWHeader(append(header.values[:len(header.values):len(header.values)], values...)))
+}
+
+// COWSnapshot represents an immutable view of a COWSlice at the time Snapshot was called.
+// Its methods never block and are safe for concurrent use by multiple goroutines.
+// A snapshot must not be used after Release is called.
+type COWSnapshot[T any] struct {
+	buffer   *cowBuffer[T]
+	values   Slice[T]
+	released atomic.Bool
+}
+
+// All returns an iterator over the index-value pairs of the snapshot.
+func (snapshot *COWSnapshot[T]) All() iter.Seq2[int, T] {
+	return snapshot.values.All()
+}
+
+// Each applies the given function to each element of the snapshot and returns the snapshot.
+func (snapshot *COWSnapshot[T]) Each(fn func(i int, value T)) *COWSnapshot[T] {
+	snapshot.values.Each(fn)
+	return snapshot
+}
+
+// Fetch returns the element at the specified index, or a zero value if the index is out of bounds.
+func (snapshot *COWSnapshot[T]) Fetch(i int) T {
+	return snapshot.values.Fetch(i)
+}
+
+// Get returns the element at the specified index and true, or a zero value and false if the index is out of bounds.
+func (snapshot *COWSnapshot[T]) Get(i int) (T, bool) {
+	return snapshot.values.Get(i)
+}
+
+// IsEmpty returns true if the snapshot is empty, or false otherwise.
+func (snapshot *COWSnapshot[T]) IsEmpty() bool {
+	return snapshot.values.IsEmpty()
+}
+
+// Length returns the number of elements in the snapshot.
+func (snapshot *COWSnapshot[T]) Length() int {
+	return snapshot.values.Length()
+}
+
+// Release tells the COWSlice that the snapshot is no longer used, so a later write may reuse its array.
+// Calling Release more than once has no effect.
+func (snapshot *COWSnapshot[T]) Release() {
+	if snapshot.released.CompareAndSwap(false, true) && snapshot.buffer != nil {
+		snapshot.buffer.refs.Add(-1)
+	}
+}
+
+// ToSlice returns a new slice containing a copy of the elements of the snapshot.
+func (snapshot *COWSnapshot[T]) ToSlice() *Slice[T] {
+	newSlice := make(Slice[T], len(snapshot.values))
+	copy(newSlice, snapshot.values)
+	return &newSlice
+}
+
+// Values returns an iterator over the elements of the snapshot.
+func (snapshot *COWSnapshot[T]) Values() iter.Seq[T] {
+	return snapshot.values.Values()
+}
+
+// cowBuffer is a backing array shared by the headers of a COWSlice, its snapshots and its clones.
+type cowBuffer[T any] struct {
+	values Slice[T]
+	refs   atomic.Int64 // The snapshots, clones and reads that use the array.
+	extent atomic.Int64 // The index just past the values published by any header over the array.
+}
+
+// cowHeader is a published view of part of a backing array.
+type cowHeader[T any] struct {
+	buffer *cowBuffer[T]
+	values Slice[T]
+	end    int // The index in the backing array just past values.
+}
+
+// claim reserves the n slots of the backing array after the header and reports whether they can be written.
+// The slots must fit in the capacity and no published header may cover them, unless nothing holds the array:
+// a reader that loads a header after that has to load the current one, which ends before the slots.
+func (header *cowHeader[T]) claim(n int) bool {
+	if len(header.values)+n > cap(header.values) {
+		return false
+	}
+	end := int64(header.end)
+	if header.buffer.extent.CompareAndSwap(end, end+int64(n)) {
+		return true
+	}
+	if header.buffer.refs.Load() == 0 {
+		header.buffer.extent.Store(end + int64(n)) // Only the slots that Pop removed were published.
+		return true
+	}
+	return false
+}
+
+// newCOWHeader returns a header over values, which become a new backing array.
+func newCOWHeader[T any](values Slice[T]) *cowHeader[T] {
+	buffer := &cowBuffer[T]{values: values}
+	buffer.extent.Store(int64(len(values)))
+	return &cowHeader[T]{buffer: buffer, values: values, end: len(values)}
+}
+
+// NewCOW creates a new instance of the COWSlice[T] type and initializes it with the provided values.
+func NewCOW[T any](values ...T) *COWSlice[T] {
+	newSlice := &COWSlice[T]{}
+	newSlice.header.Store(newCOWHeader(*New(values...)))
+	return newSlice
+}
@@ -0,0 +1,250 @@
+package slice_test
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/lindsaygelle/slice"
+)
+
+func TestCOWAppend(t *testing.T) {
+	// Test case 1: Append to a zero value.
+	s := &slice.COWSlice[int]{}
+	s.Append(1, 2).Prepend(0)
+
+	expected := &slice.Slice[int]{0, 1, 2}
+	if result := s.ToSlice(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+
+	// Test case 2: Append after a snapshot does not change the snapshot.
+	snapshot := s.Snapshot()
+	s.Append(3)
+
+	if result := snapshot.ToSlice(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+	snapshot.Release()
+
+	// Test case 3: Append after Pop does not overwrite a value an outstanding snapshot can see.
+	s = slice.NewCOW[int](1, 2, 3)
+	s.Append(4)
+	snapshot = s.Snapshot()
+	s.Pop()
+	s.Append(5)
+	if result := snapshot.ToSlice(); !reflect.DeepEqual(result, &slice.Slice[int]{1, 2, 3, 4}) {
+		t.Errorf("Expected %v, but got %v", &slice.Slice[int]{1, 2, 3, 4}, result)
+	}
+	snapshot.Release()
+	s.Pop()
+	s.Append(6)
+	if result := s.ToSlice(); !reflect.DeepEqual(result, &slice.Slice[int]{1, 2, 3, 6}) {
+		t.Errorf("Expected %v, but got %v", &slice.Slice[int]{1, 2, 3, 6}, result)
+	}
+
+	// Test case 4: Appending one value at a time grows the backing array like the built-in append.
+	// Copying on every Append would take minutes here.
+	s = &slice.COWSlice[int]{}
+	for i := 0; i < 200000; i++ {
+		s.Append(i)
+	}
+	s.Concatenate(&slice.Slice[int]{200000})
+	if s.Length() != 200001 || s.Fetch(0) != 0 || s.Fetch(200000) != 200000 {
+		t.Errorf("Expected 200001 values from 0 to 200000, but got %d values", s.Length())
+	}
+}
+
+func TestCOWAppendConcurrent(t *testing.T) {
+	// Test case: A snapshot never changes while the writer appends and pops, so reused slots were not visible.
+	// Run with -race to also check that appends only write slots no reader can see.
+	s := &slice.COWSlice[int]{}
+	var done atomic.Bool
+	var waitGroup sync.WaitGroup
+
+	waitGroup.Add(1)
+	go func() {
+		defer waitGroup.Done()
+		defer done.Store(true)
+		for k := 1; k <= 20000; k++ {
+			if k%3 == 0 {
+				s.Pop()
+			} else {
+				s.Append(k)
+			}
+		}
+	}()
+
+	var changed atomic.Int64
+	for i := 0; i < 4; i++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			for !done.Load() {
+				snapshot := s.Snapshot()
+				before := snapshot.ToSlice()
+				if after := snapshot.ToSlice(); !reflect.DeepEqual(before, after) {
+					changed.Add(1)
+				}
+				snapshot.Release()
+			}
+		}()
+	}
+	waitGroup.Wait()
+
+	if count := changed.Load(); count != 0 {
+		t.Errorf("Expected no snapshot to change, but %d did", count)
+	}
+}
+
+func TestCOWClone(t *testing.T) {
+	// Test case 1: Writes to a clone and to the original are independent.
+	s1 := slice.NewCOW[int](1, 2, 3)
+	s2 := s1.Clone()
+	s1.Replace(0, 10)
+	s2.Delete(2)
+
+	if result := s1.ToSlice(); !reflect.DeepEqual(result, &slice.Slice[int]{10, 2, 3}) {
+		t.Errorf("Expected %v, but got %v", &slice.Slice[int]{10, 2, 3}, result)
+	}
+	if result := s2.ToSlice(); !reflect.DeepEqual(result, &slice.Slice[int]{1, 2}) {
+		t.Errorf("Expected %v, but got %v", &slice.Slice[int]{1, 2}, result)
+	}
+
+	// Test case 2: Clones that share spare capacity do not append into the same slots.
+	s1 = slice.NewCOW[int](1, 2, 3)
+	s1.Append(4)
+	s2 = s1.Clone()
+	s1.Append(5)
+	s2.Append(6)
+	if result := s1.ToSlice(); !reflect.DeepEqual(result, &slice.Slice[int]{1, 2, 3, 4, 5}) {
+		t.Errorf("Expected %v, but got %v", &slice.Slice[int]{1, 2, 3, 4, 5}, result)
+	}
+	if result := s2.ToSlice(); !reflect.DeepEqual(result, &slice.Slice[int]{1, 2, 3, 4, 6}) {
+		t.Errorf("Expected %v, but got %v", &slice.Slice[int]{1, 2, 3, 4, 6}, result)
+	}
+}
+
+func TestCOWPollPop(t *testing.T) {
+	s := slice.NewCOW[int](1, 2, 3)
+	snapshot := s.Snapshot()
+	defer snapshot.Release()
+
+	if value, ok := s.PollOK(); !ok || value != 1 {
+		t.Errorf("Expected 1, true but got %d, %v", value, ok)
+	}
+	if value := s.Pop(); value != 3 {
+		t.Errorf("Expected 3, but got %d", value)
+	}
+	if result := s.ToSlice(); !reflect.DeepEqual(result, &slice.Slice[int]{2}) {
+		t.Errorf("Expected %v, but got %v", &slice.Slice[int]{2}, result)
+	}
+	if result := snapshot.ToSlice(); !reflect.DeepEqual(result, &slice.Slice[int]{1, 2, 3}) {
+		t.Errorf("Expected %v, but got %v", &slice.Slice[int]{1, 2, 3}, result)
+	}
+	if _, ok := (&slice.COWSlice[int]{}).PopOK(); ok {
+		t.Errorf("Expected false, but got true")
+	}
+}
+
+func TestCOWSnapshot(t *testing.T) {
+	s := slice.NewCOW[int](1, 2, 3)
+
+	// Test case 1: Every write after a snapshot leaves the snapshot unchanged.
+	snapshot := s.Snapshot()
+	s.Replace(0, 10)
+	s.Reverse()
+	s.SortFunc(func(i int, j int, a int, b int) bool {
+		return a < b
+	})
+
+	if result := snapshot.ToSlice(); !reflect.DeepEqual(result, &slice.Slice[int]{1, 2, 3}) {
+		t.Errorf("Expected %v, but got %v", &slice.Slice[int]{1, 2, 3}, result)
+	}
+	if result := s.ToSlice(); !reflect.DeepEqual(result, &slice.Slice[int]{2, 3, 10}) {
+		t.Errorf("Expected %v, but got %v", &slice.Slice[int]{2, 3, 10}, result)
+	}
+
+	// Test case 2: Release is idempotent.
+	snapshot.Release()
+	snapshot.Release()
+
+	// Test case 3: Snapshot of a zero value.
+	empty := (&slice.COWSlice[int]{}).Snapshot()
+	if !empty.IsEmpty() || empty.Length() != 0 {
+		t.Errorf("Expected an empty snapshot")
+	}
+	empty.Release()
+}
+
+func TestCOWReadDuringWrite(t *testing.T) {
+	// Test case: Reads do not wait for a writer. Reading inside Do would deadlock if reads took a lock.
+	s := slice.NewCOW[int](1, 2, 3)
+	s.Do(func(values *slice.Slice[int]) {
+		values.Replace(0, 10)
+		snapshot := s.Snapshot()
+		defer snapshot.Release()
+		if value := s.Fetch(0); value != 1 || s.Length() != 3 || snapshot.Fetch(0) != 1 {
+			t.Errorf("Expected the published values while writing, but got %d", value)
+		}
+	})
+	if value := s.Fetch(0); value != 10 {
+		t.Errorf("Expected 10, but got %d", value)
+	}
+}
+
+func TestCOWTornWrite(t *testing.T) {
+	// Test case: Readers never see a partially applied write. The writer sets every element to the same value,
+	// so a snapshot holding two different values would be torn. Run with -race to also check for data races.
+	const length = 256
+	s := slice.NewCOW(make([]int, length)...)
+	var done atomic.Bool
+	var waitGroup sync.WaitGroup
+
+	waitGroup.Add(1)
+	go func() {
+		defer waitGroup.Done()
+		defer done.Store(true)
+		for k := 1; k <= 2000; k++ {
+			s.Do(func(s *slice.Slice[int]) {
+				s.Modify(func(i int, value int) int {
+					return k
+				})
+			})
+		}
+	}()
+
+	var torn atomic.Int64
+	for i := 0; i < 8; i++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			for !done.Load() {
+				snapshot := s.Snapshot()
+				first := snapshot.Fetch(0)
+				for value := range snapshot.Values() {
+					if value != first {
+						torn.Add(1)
+						break
+					}
+				}
+				if snapshot.Length() != length {
+					torn.Add(1)
+				}
+				snapshot.Release()
+				if s.Length() != length || s.Fetch(length-1) < first {
+					torn.Add(1) // Direct reads see the same or a later write.
+				}
+			}
+		}()
+	}
+	waitGroup.Wait()
+
+	if count := torn.Load(); count != 0 {
+		t.Errorf("Expected no torn reads, but got %d", count)
+	}
+	if value := s.Fetch(length - 1); value != 2000 {
+		t.Errorf("Expected 2000, but got %d", value)
+	}
+}
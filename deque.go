@@ -0,0 +1,216 @@
+package slice
+
+// dequeMinCapacity is the smallest buffer a Deque allocates.
+const dequeMinCapacity = 16
+
+// Deque represents a generic double-ended queue backed by a growable ring buffer.
+// Values can be added and removed at both ends in amortized O(1) time, and removed
+// slots are cleared so the Deque does not hold on to values it no longer contains.
+// The buffer shrinks when the Deque becomes mostly empty.
+//
+// The zero value is an empty Deque ready to use.
+type Deque[T any] struct {
+	buffer []T
+	head   int
+	length int
+}
+
+// Each applies the given function to each value of the Deque from front to back and returns the Deque.
+//
+//	newDeque := slice.NewDeque[int](1, 2, 3)
+//	newDeque.Each(func(i int, value int) {
+//	    fmt.Println(value)
+//	})
+//	// Output:
+//	// 1
+//	// 2
+//	// 3
+func (deque *Deque[T]) Each(fn func(i int, value T)) *Deque[T] {
+	for i := 0; i < deque.length; i++ {
+		fn(i, deque.buffer[deque.index(i)])
+	}
+	return deque
+}
+
+// Fetch returns the value at the specified index counted from the front, or a zero value if the index is out of bounds.
+//
+//	newDeque := slice.NewDeque[int](1, 2, 3)
+//	value := newDeque.Fetch(1)
+//	fmt.Println(value) // 2
+func (deque *Deque[T]) Fetch(i int) T {
+	value, _ := deque.Get(i)
+	return value
+}
+
+// Get returns the value at the specified index counted from the front and true, or a zero value and false if the index is out of bounds.
+//
+//	newDeque := slice.NewDeque[int](1, 2, 3)
+//	value, ok := newDeque.Get(1)
+//	fmt.Println(value, ok) // 2, true
+func (deque *Deque[T]) Get(i int) (T, bool) {
+	var value T
+	if i < 0 || i >= deque.length {
+		return value, false
+	}
+	return deque.buffer[deque.index(i)], true
+}
+
+// IsEmpty returns true if the Deque is empty, or false otherwise.
+func (deque *Deque[T]) IsEmpty() bool {
+	return deque.length == 0
+}
+
+// Length returns the number of values in the Deque.
+func (deque *Deque[T]) Length() int {
+	return deque.length
+}
+
+// PeekBack returns the last value of the Deque and true without removing it, or a zero value and false if the Deque is empty.
+//
+//	newDeque := slice.NewDeque[int](1, 2, 3)
+//	value, ok := newDeque.PeekBack()
+//	fmt.Println(value, ok) // 3, true
+func (deque *Deque[T]) PeekBack() (T, bool) {
+	return deque.Get(deque.length - 1)
+}
+
+// PeekFront returns the first value of the Deque and true without removing it, or a zero value and false if the Deque is empty.
+//
+//	newDeque := slice.NewDeque[int](1, 2, 3)
+//	value, ok := newDeque.PeekFront()
+//	fmt.Println(value, ok) // 1, true
+func (deque *Deque[T]) PeekFront() (T, bool) {
+	return deque.Get(0)
+}
+
+// PopBack removes and returns the last value of the Deque and true, or a zero value and false if the Deque is empty.
+//
+//	newDeque := slice.NewDeque[int](1, 2, 3)
+//	value, ok := newDeque.PopBack()
+//	fmt.Println(value, ok) // 3, true
+func (deque *Deque[T]) PopBack() (T, bool) {
+	var zero T
+	if deque.length == 0 {
+		return zero, false
+	}
+	i := deque.index(deque.length - 1)
+	value := deque.buffer[i]
+	deque.buffer[i] = zero
+	deque.length--
+	deque.shrink()
+	return value, true
+}
+
+// PopFront removes and returns the first value of the Deque and true, or a zero value and false if the Deque is empty.
+//
+//	newDeque := slice.NewDeque[int](1, 2, 3)
+//	value, ok := newDeque.PopFront()
+//	fmt.Println(value, ok) // 1, true
+func (deque *Deque[T]) PopFront() (T, bool) {
+	var zero T
+	if deque.length == 0 {
+		return zero, false
+	}
+	value := deque.buffer[deque.head]
+	deque.buffer[deque.head] = zero
+	deque.head = deque.index(1)
+	deque.length--
+	deque.shrink()
+	return value, true
+}
+
+// PushBack adds the given values to the back of the Deque and returns the Deque.
+//
+//	newDeque := slice.NewDeque[int](1)
+//	newDeque.PushBack(2, 3)
+//	fmt.Println(newDeque.ToSlice()) // &[1, 2, 3]
+func (deque *Deque[T]) PushBack(values ...T) *Deque[T] {
+	deque.grow(len(values))
+	for _, value := range values {
+		deque.buffer[deque.index(deque.length)] = value
+		deque.length++
+	}
+	return deque
+}
+
+// PushFront adds the given values to the front of the Deque, keeping their order, and returns the Deque.
+//
+//	newDeque := slice.NewDeque[int](3)
+//	newDeque.PushFront(1, 2)
+//	fmt.Println(newDeque.ToSlice()) // &[1, 2, 3]
+func (deque *Deque[T]) PushFront(values ...T) *Deque[T] {
+	deque.grow(len(values))
+	for i := len(values) - 1; i >= 0; i-- {
+		deque.head = deque.index(len(deque.buffer) - 1)
+		deque.buffer[deque.head] = values[i]
+		deque.length++
+	}
+	return deque
+}
+
+// Replace replaces the value at the specified index counted from the front and returns true if the index is valid, or false otherwise.
+//
+//	newDeque := slice.NewDeque[int](1, 2, 3)
+//	ok := newDeque.Replace(1, 10)
+//	fmt.Println(newDeque.ToSlice(), ok) // &[1, 10, 3], true
+func (deque *Deque[T]) Replace(i int, value T) bool {
+	if i < 0 || i >= deque.length {
+		return false
+	}
+	deque.buffer[deque.index(i)] = value
+	return true
+}
+
+// ToSlice returns a new slice containing the values of the Deque from front to back.
+//
+//	newDeque := slice.NewDeque[int](1, 2, 3)
+//	fmt.Println(newDeque.ToSlice()) // &[1, 2, 3]
+func (deque *Deque[T]) ToSlice() *Slice[T] {
+	newSlice := make(Slice[T], deque.length)
+	deque.copyTo(newSlice)
+	return &newSlice
+}
+
+// copyTo copies the values of the Deque from front to back into the given slice.
+func (deque *Deque[T]) copyTo(values []T) {
+	n := copy(values, deque.buffer[deque.head:min(deque.head+deque.length, len(deque.buffer))])
+	copy(values[n:], deque.buffer[:deque.length-n])
+}
+
+// grow makes room for n more values.
+func (deque *Deque[T]) grow(n int) {
+	if deque.length+n <= len(deque.buffer) {
+		return
+	}
+	capacity := max(len(deque.buffer), dequeMinCapacity)
+	for capacity < deque.length+n {
+		capacity *= 2
+	}
+	deque.resize(capacity)
+}
+
+// index returns the buffer index of the value at logical index i.
+func (deque *Deque[T]) index(i int) int {
+	return (deque.head + i) % len(deque.buffer)
+}
+
+// resize moves the values of the Deque into a new buffer of the given capacity.
+func (deque *Deque[T]) resize(capacity int) {
+	buffer := make([]T, capacity)
+	deque.copyTo(buffer)
+	deque.buffer = buffer
+	deque.head = 0
+}
+
+// shrink halves the buffer when it is at most a quarter full.
+func (deque *Deque[T]) shrink() {
+	if len(deque.buffer) > dequeMinCapacity && deque.length <= len(deque.buffer)/4 {
+		deque.resize(len(deque.buffer) / 2)
+	}
+}
+
+// NewDeque creates a new instance of the Deque[T] type and initializes it with the provided values.
+// Use NewDeque(*s...) to create a Deque from a Slice.
+func NewDeque[T any](values ...T) *Deque[T] {
+	return (&Deque[T]{}).PushBack(values...)
+}
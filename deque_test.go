@@ -0,0 +1,151 @@
+package slice_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/lindsaygelle/slice"
+)
+
+func TestDequeEach(t *testing.T) {
+	// Test case: Visit values from front to back after wrapping around the buffer.
+	d := slice.NewDeque[int](3, 4)
+	d.PushFront(1, 2)
+	var result []int
+	d.Each(func(i int, value int) {
+		result = append(result, value)
+	})
+	if !reflect.DeepEqual(result, []int{1, 2, 3, 4}) {
+		t.Errorf("Expected %v, but got %v", []int{1, 2, 3, 4}, result)
+	}
+}
+
+func TestDequeGet(t *testing.T) {
+	// Test case 1: Get values by index.
+	d := slice.NewDeque[int](1, 2, 3)
+	if value, ok := d.Get(2); !ok || value != 3 {
+		t.Errorf("Expected 3 and true, but got %d and %v", value, ok)
+	}
+	if value := d.Fetch(0); value != 1 {
+		t.Errorf("Expected 1, but got %d", value)
+	}
+
+	// Test case 2: Get values out of bounds.
+	if _, ok := d.Get(3); ok {
+		t.Errorf("Expected false, but got true")
+	}
+	if _, ok := d.Get(-1); ok {
+		t.Errorf("Expected false, but got true")
+	}
+}
+
+func TestDequePeek(t *testing.T) {
+	// Test case 1: Peek at both ends.
+	d := slice.NewDeque[int](1, 2, 3)
+	if value, ok := d.PeekFront(); !ok || value != 1 {
+		t.Errorf("Expected 1 and true, but got %d and %v", value, ok)
+	}
+	if value, ok := d.PeekBack(); !ok || value != 3 {
+		t.Errorf("Expected 3 and true, but got %d and %v", value, ok)
+	}
+
+	// Test case 2: Peek at an empty Deque.
+	d = &slice.Deque[int]{}
+	if _, ok := d.PeekFront(); ok {
+		t.Errorf("Expected false, but got true")
+	}
+	if _, ok := d.PeekBack(); ok {
+		t.Errorf("Expected false, but got true")
+	}
+}
+
+func TestDequePop(t *testing.T) {
+	// Test case 1: Pop from both ends.
+	d := slice.NewDeque[int](1, 2, 3)
+	if value, ok := d.PopFront(); !ok || value != 1 {
+		t.Errorf("Expected 1 and true, but got %d and %v", value, ok)
+	}
+	if value, ok := d.PopBack(); !ok || value != 3 {
+		t.Errorf("Expected 3 and true, but got %d and %v", value, ok)
+	}
+	if length := d.Length(); length != 1 {
+		t.Errorf("Expected length 1, but got %d", length)
+	}
+
+	// Test case 2: Pop from an empty Deque.
+	d = &slice.Deque[int]{}
+	if _, ok := d.PopFront(); ok {
+		t.Errorf("Expected false, but got true")
+	}
+	if _, ok := d.PopBack(); ok {
+		t.Errorf("Expected false, but got true")
+	}
+}
+
+func TestDequePush(t *testing.T) {
+	// Test case 1: Push to both ends of a zero value Deque.
+	d := &slice.Deque[int]{}
+	d.PushBack(3, 4).PushFront(1, 2).PushBack(5)
+
+	expected := &slice.Slice[int]{1, 2, 3, 4, 5}
+	if result := d.ToSlice(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+
+	// Test case 2: Use the Deque as a long-running FIFO queue through growth and shrinking.
+	d = &slice.Deque[int]{}
+	next := 0
+	for i := 0; i < 10000; i++ {
+		d.PushBack(i)
+		if i%3 == 0 {
+			value, _ := d.PopFront()
+			if value != next {
+				t.Fatalf("Expected %d, but got %d", next, value)
+			}
+			next++
+		}
+	}
+	for !d.IsEmpty() {
+		value, _ := d.PopFront()
+		if value != next {
+			t.Fatalf("Expected %d, but got %d", next, value)
+		}
+		next++
+	}
+	if next != 10000 {
+		t.Errorf("Expected 10000 values, but got %d", next)
+	}
+}
+
+func TestDequeReplace(t *testing.T) {
+	// Test case 1: Replace a value by index.
+	d := slice.NewDeque[int](1, 2, 3)
+	if ok := d.Replace(1, 10); !ok {
+		t.Errorf("Expected true, but got false")
+	}
+
+	expected := &slice.Slice[int]{1, 10, 3}
+	if result := d.ToSlice(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+
+	// Test case 2: Replace a value out of bounds.
+	if ok := d.Replace(3, 10); ok {
+		t.Errorf("Expected false, but got true")
+	}
+}
+
+func TestNewDeque(t *testing.T) {
+	// Test case: Convert a Slice to a Deque and back.
+	s := &slice.Slice[int]{1, 2, 3}
+	d := slice.NewDeque(*s...)
+	d.PushFront(0)
+
+	expected := &slice.Slice[int]{0, 1, 2, 3}
+	if result := d.ToSlice(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+	if length := s.Length(); length != 3 {
+		t.Errorf("Expected length 3, but got %d", length)
+	}
+}
@@ -0,0 +1,398 @@
+package slice
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// ErrPatchConflict is returned by Patch.Apply when the slice does not match the values the patch expects.
+var ErrPatchConflict = errors.New("slice: patch does not apply")
+
+// HunkKind identifies what a Hunk does to the old slice.
+type HunkKind int
+
+const (
+	// HunkEqual keeps values that are present in both slices.
+	HunkEqual HunkKind = iota
+	// HunkDelete removes values that are only present in the old slice.
+	HunkDelete
+	// HunkInsert adds values that are only present in the new slice.
+	HunkInsert
+)
+
+// String returns the name of the kind.
+func (kind HunkKind) String() string {
+	switch kind {
+	case HunkEqual:
+		return "equal"
+	case HunkDelete:
+		return "delete"
+	case HunkInsert:
+		return "insert"
+	}
+	return fmt.Sprintf("HunkKind(%d)", int(kind))
+}
+
+// Hunk is a run of consecutive values with the same kind.
+// OldIndex and NewIndex are the positions of the run in the old and new slices.
+// For an insert, OldIndex is the position in the old slice the values are inserted before;
+// for a delete, NewIndex is the position in the new slice where the values would have been.
+type Hunk[T any] struct {
+	Kind     HunkKind
+	OldIndex int
+	NewIndex int
+	Values   []T
+}
+
+// Patch is the sequence of hunks that turns one slice into another.
+// Within each changed region, delete hunks come before insert hunks.
+type Patch[T any] struct {
+	Hunks []Hunk[T]
+	equal func(a T, b T) bool
+}
+
+// Apply changes the given slice into the new slice of the patch.
+// It returns an error wrapping ErrPatchConflict, and leaves the slice unchanged, if the slice does not match
+// the values the patch keeps or deletes. Patches from Diff compare values with ==, patches from DiffFunc
+// use its function and other patches use reflect.DeepEqual.
+//
+//	a := &slice.Slice[int]{1, 2, 3}
+//	b := &slice.Slice[int]{1, 3, 4}
+//	patch := slice.Diff(a, b)
+//	err := patch.Apply(a)
+//	fmt.Println(a, err) // &[1, 3, 4], <nil>
+func (patch Patch[T]) Apply(s *Slice[T]) error {
+	equal := patch.equal
+	if equal == nil {
+		equal = func(a T, b T) bool {
+			return reflect.DeepEqual(a, b)
+		}
+	}
+	newSlice := Slice[T]{}
+	var cursor int
+	for _, hunk := range patch.Hunks {
+		if hunk.Kind == HunkInsert {
+			newSlice = append(newSlice, hunk.Values...)
+			continue
+		}
+		if cursor+len(hunk.Values) > len(*s) {
+			return fmt.Errorf("%w: %s hunk at index %d exceeds length %d", ErrPatchConflict, hunk.Kind, cursor, len(*s))
+		}
+		for i, value := range hunk.Values {
+			if !equal((*s)[cursor+i], value) {
+				return fmt.Errorf("%w: %s hunk does not match at index %d", ErrPatchConflict, hunk.Kind, cursor+i)
+			}
+		}
+		if hunk.Kind == HunkEqual {
+			newSlice = append(newSlice, (*s)[cursor:cursor+len(hunk.Values)]...)
+		}
+		cursor += len(hunk.Values)
+	}
+	if cursor != len(*s) {
+		return fmt.Errorf("%w: %d values after index %d are not covered", ErrPatchConflict, len(*s)-cursor, cursor)
+	}
+	*s = newSlice
+	return nil
+}
+
+// Invert returns a patch that turns the new slice back into the old slice.
+//
+//	a := &slice.Slice[int]{1, 2, 3}
+//	b := &slice.Slice[int]{1, 3, 4}
+//	patch := slice.Diff(a, b)
+//	patch.Invert().Apply(b)
+//	fmt.Println(b) // &[1, 2, 3]
+func (patch Patch[T]) Invert() Patch[T] {
+	hunks := make([]Hunk[T], 0, len(patch.Hunks))
+	for i := 0; i < len(patch.Hunks); i++ {
+		// Swap each delete run with the insert run after it, so deletes still come first.
+		if patch.Hunks[i].Kind == HunkDelete && i+1 < len(patch.Hunks) && patch.Hunks[i+1].Kind == HunkInsert {
+			hunks = append(hunks, patch.Hunks[i+1].inverted(), patch.Hunks[i].inverted())
+			i++
+			continue
+		}
+		hunks = append(hunks, patch.Hunks[i].inverted())
+	}
+	return Patch[T]{Hunks: hunks, equal: patch.equal}
+}
+
+// inverted returns the hunk with the old and new slices swapped.
+func (hunk Hunk[T]) inverted() Hunk[T] {
+	kind := hunk.Kind
+	switch kind {
+	case HunkDelete:
+		kind = HunkInsert
+	case HunkInsert:
+		kind = HunkDelete
+	}
+	return Hunk[T]{Kind: kind, OldIndex: hunk.NewIndex, NewIndex: hunk.OldIndex, Values: hunk.Values}
+}
+
+// Diff returns the shortest patch that turns slice a into slice b, computed with the Myers O(ND) algorithm,
+// where N is the combined length of the slices and D is the number of values inserted or deleted.
+//
+//	a := &slice.Slice[string]{"a", "b", "c"}
+//	b := &slice.Slice[string]{"a", "c", "d"}
+//	patch := slice.Diff(a, b)
+//	fmt.Println(patch.Hunks) // [{equal 0 0 [a]} {delete 1 1 [b]} {equal 2 1 [c]} {insert 3 2 [d]}]
+func Diff[T comparable](a *Slice[T], b *Slice[T]) Patch[T] {
+	return DiffFunc(a, b, func(a T, b T) bool {
+		return a == b
+	})
+}
+
+// DiffFunc returns the shortest patch that turns slice a into slice b using the provided function to compare values.
+// Equal hunks hold the values of a.
+func DiffFunc[T any](a *Slice[T], b *Slice[T], fn func(a T, b T) bool) Patch[T] {
+	oldValues, newValues := *a, *b
+	operations := myers(len(oldValues), len(newValues), func(i int, j int) bool {
+		return fn(oldValues[i], newValues[j])
+	})
+	patch := Patch[T]{Hunks: []Hunk[T]{}, equal: fn}
+	var oldIndex, newIndex int
+	for _, operation := range operations {
+		var values []T
+		switch operation.kind {
+		case HunkEqual, HunkDelete:
+			values = oldValues[oldIndex : oldIndex+operation.length]
+		case HunkInsert:
+			values = newValues[newIndex : newIndex+operation.length]
+		}
+		patch.Hunks = append(patch.Hunks, Hunk[T]{
+			Kind:     operation.kind,
+			OldIndex: oldIndex,
+			NewIndex: newIndex,
+			Values:   slices.Clone(values),
+		})
+		if operation.kind != HunkInsert {
+			oldIndex += operation.length
+		}
+		if operation.kind != HunkDelete {
+			newIndex += operation.length
+		}
+	}
+	return patch
+}
+
+// UnifiedDiff formats the patch between two string slices as a unified diff with the given number of context lines.
+// It returns an empty string if the patch makes no changes.
+//
+//	a := &slice.Slice[string]{"a", "b", "c"}
+//	b := &slice.Slice[string]{"a", "c", "d"}
+//	fmt.Print(slice.UnifiedDiff("old", "new", slice.Diff(a, b), 1))
+//	// Output:
+//	// --- old
+//	// +++ new
+//	// @@ -1,3 +1,3 @@
+//	//  a
+//	// -b
+//	//  c
+//	// +d
+func UnifiedDiff(oldName string, newName string, patch Patch[string], context int) string {
+	context = max(context, 0)
+	type line struct {
+		kind               HunkKind
+		oldIndex, newIndex int
+		value              string
+	}
+	var lines []line
+	var changed []int
+	for _, hunk := range patch.Hunks {
+		for i, value := range hunk.Values {
+			oldIndex, newIndex := hunk.OldIndex, hunk.NewIndex
+			switch hunk.Kind {
+			case HunkEqual:
+				oldIndex, newIndex = oldIndex+i, newIndex+i
+			case HunkDelete:
+				oldIndex += i
+			case HunkInsert:
+				newIndex += i
+			}
+			if hunk.Kind != HunkEqual {
+				changed = append(changed, len(lines))
+			}
+			lines = append(lines, line{kind: hunk.Kind, oldIndex: oldIndex, newIndex: newIndex, value: value})
+		}
+	}
+	if len(changed) == 0 {
+		return ""
+	}
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "--- %s\n+++ %s\n", oldName, newName)
+	for i := 0; i < len(changed); {
+		// Extend the group while the gap to the next change fits inside the shared context.
+		j := i
+		for j+1 < len(changed) && changed[j+1]-changed[j] <= 2*context+1 {
+			j++
+		}
+		start, end := max(changed[i]-context, 0), min(changed[j]+context+1, len(lines))
+		var oldStart, oldCount, newStart, newCount int
+		oldStart, newStart = -1, -1
+		for _, l := range lines[start:end] {
+			if l.kind != HunkInsert {
+				if oldStart < 0 {
+					oldStart = l.oldIndex
+				}
+				oldCount++
+			}
+			if l.kind != HunkDelete {
+				if newStart < 0 {
+					newStart = l.newIndex
+				}
+				newCount++
+			}
+		}
+		if oldStart < 0 {
+			oldStart = lines[start].oldIndex
+		}
+		if newStart < 0 {
+			newStart = lines[start].newIndex
+		}
+		fmt.Fprintf(&builder, "@@ -%s +%s @@\n", unifiedRange(oldStart, oldCount), unifiedRange(newStart, newCount))
+		for _, l := range lines[start:end] {
+			prefix := " "
+			switch l.kind {
+			case HunkDelete:
+				prefix = "-"
+			case HunkInsert:
+				prefix = "+"
+			}
+			builder.WriteString(prefix + l.value + "\n")
+		}
+		i = j + 1
+	}
+	return builder.String()
+}
+
+// unifiedRange formats a 0-based start index and a count as a unified diff range.
+func unifiedRange(start int, count int) string {
+	switch count {
+	case 0:
+		return fmt.Sprintf("%d,0", start) // An empty range names the line before it.
+	case 1:
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
+
+// diffOperation is a run of operations of one kind produced by myers.
+type diffOperation struct {
+	kind   HunkKind
+	length int
+}
+
+// myers returns the shortest edit script between sequences of length n and m as runs of operations,
+// with deletes before inserts in each changed region. equal reports whether old value i equals new value j.
+func myers(n int, m int, equal func(i int, j int) bool) []diffOperation {
+	// Trim the common prefix and suffix, which are usually most of the input.
+	var prefix int
+	for prefix < n && prefix < m && equal(prefix, prefix) {
+		prefix++
+	}
+	var suffix int
+	for suffix < n-prefix && suffix < m-prefix && equal(n-1-suffix, m-1-suffix) {
+		suffix++
+	}
+	n, m = n-prefix-suffix, m-prefix-suffix
+	inner := func(i int, j int) bool {
+		return equal(prefix+i, prefix+j)
+	}
+
+	// Forward pass: record the furthest reaching x on each diagonal k after every edit count d.
+	offset := n + m + 1
+	v := make([]int, 2*offset+1)
+	var trace [][]int
+	var steps []HunkKind // Reversed edit script, one entry per value.
+	for d := 0; d <= n+m; d++ {
+		done := false
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && inner(x, y) {
+				x, y = x+1, y+1
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				done = true
+				break
+			}
+		}
+		trace = append(trace, slices.Clone(v[offset-d:offset+d+1]))
+		if done {
+			break
+		}
+	}
+
+	// Backtrack from (n, m) to (0, 0) through the recorded diagonals.
+	x, y := n, m
+	for d := len(trace) - 1; d > 0; d-- {
+		previous := trace[d-1]
+		get := func(k int) int {
+			return previous[k+d-1]
+		}
+		k := x - y
+		var previousK int
+		if k == -d || (k != d && get(k-1) < get(k+1)) {
+			previousK = k + 1
+		} else {
+			previousK = k - 1
+		}
+		previousX := get(previousK)
+		previousY := previousX - previousK
+		for x > previousX && y > previousY {
+			steps = append(steps, HunkEqual)
+			x, y = x-1, y-1
+		}
+		if x == previousX {
+			steps = append(steps, HunkInsert)
+		} else {
+			steps = append(steps, HunkDelete)
+		}
+		x, y = previousX, previousY
+	}
+	for ; x > 0 && y > 0; x, y = x-1, y-1 {
+		steps = append(steps, HunkEqual)
+	}
+	slices.Reverse(steps)
+
+	// Group the steps into runs, moving deletes before inserts within each changed region.
+	var operations []diffOperation
+	add := func(kind HunkKind, length int) {
+		if length == 0 {
+			return
+		}
+		if last := len(operations) - 1; last >= 0 && operations[last].kind == kind {
+			operations[last].length += length
+			return
+		}
+		operations = append(operations, diffOperation{kind: kind, length: length})
+	}
+	add(HunkEqual, prefix)
+	for i := 0; i < len(steps); {
+		if steps[i] == HunkEqual {
+			add(HunkEqual, 1)
+			i++
+			continue
+		}
+		var deletes, inserts int
+		for ; i < len(steps) && steps[i] != HunkEqual; i++ {
+			if steps[i] == HunkDelete {
+				deletes++
+			} else {
+				inserts++
+			}
+		}
+		add(HunkDelete, deletes)
+		add(HunkInsert, inserts)
+	}
+	add(HunkEqual, suffix)
+	return operations
+}
@@ -0,0 +1,167 @@
+package slice_test
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/lindsaygelle/slice"
+)
+
+func TestDiff(t *testing.T) {
+	// Test case 1: A change in the middle.
+	a := &slice.Slice[string]{"a", "b", "c"}
+	b := &slice.Slice[string]{"a", "c", "d"}
+	patch := slice.Diff(a, b)
+
+	expected := []slice.Hunk[string]{
+		{Kind: slice.HunkEqual, OldIndex: 0, NewIndex: 0, Values: []string{"a"}},
+		{Kind: slice.HunkDelete, OldIndex: 1, NewIndex: 1, Values: []string{"b"}},
+		{Kind: slice.HunkEqual, OldIndex: 2, NewIndex: 1, Values: []string{"c"}},
+		{Kind: slice.HunkInsert, OldIndex: 3, NewIndex: 2, Values: []string{"d"}},
+	}
+	if !reflect.DeepEqual(patch.Hunks, expected) {
+		t.Errorf("Expected %v, but got %v", expected, patch.Hunks)
+	}
+
+	// Test case 2: Deletes come before inserts in a replaced region.
+	var kinds []slice.HunkKind
+	for _, hunk := range slice.Diff(&slice.Slice[int]{1, 2, 3}, &slice.Slice[int]{1, 4, 3}).Hunks {
+		kinds = append(kinds, hunk.Kind)
+	}
+	expectedKinds := []slice.HunkKind{slice.HunkEqual, slice.HunkDelete, slice.HunkInsert, slice.HunkEqual}
+	if !reflect.DeepEqual(kinds, expectedKinds) {
+		t.Errorf("Expected %v, but got %v", expectedKinds, kinds)
+	}
+
+	// Test case 3: Empty slices.
+	if hunks := slice.Diff(&slice.Slice[int]{}, &slice.Slice[int]{}).Hunks; len(hunks) != 0 {
+		t.Errorf("Expected no hunks, but got %v", hunks)
+	}
+}
+
+func TestDiffRandom(t *testing.T) {
+	// Test case: Apply reproduces b, Invert reproduces a and the edit count is minimal.
+	random := rand.New(rand.NewSource(1))
+	for iteration := 0; iteration < 300; iteration++ {
+		a, b := &slice.Slice[int]{}, &slice.Slice[int]{}
+		for i := random.Intn(30); i > 0; i-- {
+			a.Append(random.Intn(4))
+		}
+		for i := random.Intn(30); i > 0; i-- {
+			b.Append(random.Intn(4))
+		}
+		patch := slice.Diff(a, b)
+
+		result := a.Clone()
+		if err := patch.Apply(result); err != nil || !reflect.DeepEqual(result, b) {
+			t.Fatalf("Apply(%v, %v): expected %v, but got %v (%v)", a, b, b, result, err)
+		}
+		if err := patch.Invert().Apply(result); err != nil || !reflect.DeepEqual(result, a) {
+			t.Fatalf("Invert(%v, %v): expected %v, but got %v (%v)", a, b, a, result, err)
+		}
+
+		var edits int
+		for _, hunk := range patch.Hunks {
+			if hunk.Kind != slice.HunkEqual {
+				edits += len(hunk.Values)
+			}
+		}
+		if expected := a.Length() + b.Length() - 2*lcsLength(*a, *b); edits != expected {
+			t.Fatalf("Diff(%v, %v): expected %d edits, but got %d", a, b, expected, edits)
+		}
+	}
+}
+
+// lcsLength returns the length of the longest common subsequence of a and b.
+func lcsLength(a []int, b []int) int {
+	table := make([][]int, len(a)+1)
+	for i := range table {
+		table[i] = make([]int, len(b)+1)
+	}
+	for i := range a {
+		for j := range b {
+			if a[i] == b[j] {
+				table[i+1][j+1] = table[i][j] + 1
+			} else {
+				table[i+1][j+1] = max(table[i][j+1], table[i+1][j])
+			}
+		}
+	}
+	return table[len(a)][len(b)]
+}
+
+func TestDiffFunc(t *testing.T) {
+	// Test case: Compare values case-insensitively.
+	a := &slice.Slice[string]{"A", "b"}
+	b := &slice.Slice[string]{"a", "B", "c"}
+	patch := slice.DiffFunc(a, b, func(a string, b string) bool {
+		return len(a) == len(b) && (a == b || a[0]^b[0] == 'a'^'A')
+	})
+
+	if len(patch.Hunks) != 2 || patch.Hunks[0].Kind != slice.HunkEqual || patch.Hunks[1].Kind != slice.HunkInsert {
+		t.Errorf("Expected an equal hunk and an insert hunk, but got %v", patch.Hunks)
+	}
+	expected := &slice.Slice[string]{"A", "b", "c"}
+	if err := patch.Apply(a); err != nil || !reflect.DeepEqual(a, expected) {
+		t.Errorf("Expected %v, but got %v (%v)", expected, a, err)
+	}
+}
+
+func TestPatchApplyConflict(t *testing.T) {
+	patch := slice.Diff(&slice.Slice[int]{1, 2, 3}, &slice.Slice[int]{1, 3})
+
+	tests := []*slice.Slice[int]{
+		{1, 5, 3},    // A deleted value differs.
+		{1, 2},       // Too short.
+		{1, 2, 3, 4}, // Values left over.
+	}
+	for _, test := range tests {
+		original := test.Clone()
+		if err := patch.Apply(test); !errors.Is(err, slice.ErrPatchConflict) {
+			t.Errorf("Apply(%v): expected ErrPatchConflict, but got %v", original, err)
+		}
+		if !reflect.DeepEqual(test, original) {
+			t.Errorf("Expected %v to be unchanged, but got %v", original, test)
+		}
+	}
+
+	// Test case: A patch built by hand compares values with reflect.DeepEqual.
+	handmade := slice.Patch[[]int]{Hunks: []slice.Hunk[[]int]{{Kind: slice.HunkDelete, Values: [][]int{{1}}}}}
+	s := &slice.Slice[[]int]{{1}}
+	if err := handmade.Apply(s); err != nil || s.Length() != 0 {
+		t.Errorf("Expected an empty slice, but got %v (%v)", s, err)
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	a := &slice.Slice[string]{"a", "b", "c", "d", "e", "f", "g", "h"}
+	b := &slice.Slice[string]{"x", "a", "b", "c", "d", "e", "g", "h"}
+
+	// Test case 1: Changes further apart than the context produce separate ranges.
+	expected := "--- old\n+++ new\n" +
+		"@@ -1 +1,2 @@\n+x\n a\n" +
+		"@@ -5,3 +6,2 @@\n e\n-f\n g\n"
+	if result := slice.UnifiedDiff("old", "new", slice.Diff(a, b), 1); result != expected {
+		t.Errorf("Expected %q, but got %q", expected, result)
+	}
+
+	// Test case 2: Changes within twice the context are merged.
+	expected = "--- old\n+++ new\n" +
+		"@@ -1,8 +1,8 @@\n+x\n a\n b\n c\n d\n e\n-f\n g\n h\n"
+	if result := slice.UnifiedDiff("old", "new", slice.Diff(a, b), 3); result != expected {
+		t.Errorf("Expected %q, but got %q", expected, result)
+	}
+
+	// Test case 3: An insert without context names the line before it.
+	expected = "--- old\n+++ new\n@@ -0,0 +1 @@\n+x\n@@ -6 +6,0 @@\n-f\n"
+	if result := slice.UnifiedDiff("old", "new", slice.Diff(a, b), 0); result != expected {
+		t.Errorf("Expected %q, but got %q", expected, result)
+	}
+
+	// Test case 4: No changes.
+	if result := slice.UnifiedDiff("old", "new", slice.Diff(a, a), 3); result != "" {
+		t.Errorf("Expected an empty string, but got %q", result)
+	}
+}
@@ -0,0 +1,258 @@
+package slice
+
+import (
+	"fmt"
+	"slices"
+)
+
+// EditKind identifies a step of an Alignment.
+type EditKind int
+
+const (
+	// EditMatch pairs a value of the old slice with an equal value of the new slice.
+	EditMatch EditKind = iota
+	// EditSubstitute replaces a value of the old slice with a different value of the new slice.
+	EditSubstitute
+	// EditDelete removes a value of the old slice.
+	EditDelete
+	// EditInsert adds a value of the new slice.
+	EditInsert
+	// EditTranspose swaps two adjacent values of the old slice to match two values of the new slice.
+	EditTranspose
+)
+
+// String returns the name of the kind.
+func (kind EditKind) String() string {
+	switch kind {
+	case EditMatch:
+		return "match"
+	case EditSubstitute:
+		return "substitute"
+	case EditDelete:
+		return "delete"
+	case EditInsert:
+		return "insert"
+	case EditTranspose:
+		return "transpose"
+	}
+	return fmt.Sprintf("EditKind(%d)", int(kind))
+}
+
+// Edit is one step of an Alignment. OldIndex and NewIndex are the positions of the step in the old and new slices.
+// For an insert, OldIndex is the position in the old slice the value is inserted before; for a delete, NewIndex is
+// the position in the new slice where the value would have been. A transpose covers the values at OldIndex and
+// OldIndex+1 and at NewIndex and NewIndex+1.
+type Edit struct {
+	Kind     EditKind
+	OldIndex int
+	NewIndex int
+}
+
+// Alignment is the sequence of edits, in order, that lines up an old slice with a new slice.
+type Alignment []Edit
+
+// DamerauLevenshtein returns the optimal string alignment distance between slices a and b and the alignment that
+// achieves it. This is the Levenshtein distance with the transposition of two adjacent values also counted as one edit,
+// under the restriction that no value is edited more than once.
+//
+//	a := &slice.Slice[rune]{'a', 'b', 'c'}
+//	b := &slice.Slice[rune]{'a', 'c', 'b'}
+//	distance, _ := slice.DamerauLevenshtein(a, b)
+//	fmt.Println(distance) // 1
+func DamerauLevenshtein[T comparable](a *Slice[T], b *Slice[T]) (int, Alignment) {
+	return DamerauLevenshteinFunc(a, b, equalComparable[T])
+}
+
+// DamerauLevenshteinFunc is like DamerauLevenshtein but uses the provided function to compare values.
+func DamerauLevenshteinFunc[T any](a *Slice[T], b *Slice[T], fn func(a T, b T) bool) (int, Alignment) {
+	return editDistance(*a, *b, fn, true)
+}
+
+// Hamming returns the number of positions at which slices a and b differ and the alignment of each position,
+// or -1 and a nil alignment if the slices have different lengths.
+//
+//	a := &slice.Slice[int]{1, 2, 3}
+//	b := &slice.Slice[int]{1, 5, 3}
+//	distance, _ := slice.Hamming(a, b)
+//	fmt.Println(distance) // 1
+func Hamming[T comparable](a *Slice[T], b *Slice[T]) (int, Alignment) {
+	return HammingFunc(a, b, equalComparable[T])
+}
+
+// HammingFunc is like Hamming but uses the provided function to compare values.
+func HammingFunc[T any](a *Slice[T], b *Slice[T], fn func(a T, b T) bool) (int, Alignment) {
+	if len(*a) != len(*b) {
+		return -1, nil
+	}
+	var distance int
+	alignment := make(Alignment, len(*a))
+	for i := range *a {
+		kind := EditMatch
+		if !fn((*a)[i], (*b)[i]) {
+			kind = EditSubstitute
+			distance++
+		}
+		alignment[i] = Edit{Kind: kind, OldIndex: i, NewIndex: i}
+	}
+	return distance, alignment
+}
+
+// LCS returns the length of the longest common subsequence of slices a and b and an alignment of match, delete and
+// insert edits in which the matches form that subsequence.
+//
+//	a := &slice.Slice[int]{1, 2, 3, 4}
+//	b := &slice.Slice[int]{2, 4, 5}
+//	length, _ := slice.LCS(a, b)
+//	fmt.Println(length) // 2
+func LCS[T comparable](a *Slice[T], b *Slice[T]) (int, Alignment) {
+	return LCSFunc(a, b, equalComparable[T])
+}
+
+// LCSFunc is like LCS but uses the provided function to compare values.
+func LCSFunc[T any](a *Slice[T], b *Slice[T], fn func(a T, b T) bool) (int, Alignment) {
+	oldValues, newValues := *a, *b
+	table := newDistanceTable(len(oldValues), len(newValues))
+	for i := 1; i <= len(oldValues); i++ {
+		for j := 1; j <= len(newValues); j++ {
+			if fn(oldValues[i-1], newValues[j-1]) {
+				table[i][j] = table[i-1][j-1] + 1
+			} else {
+				table[i][j] = max(table[i-1][j], table[i][j-1])
+			}
+		}
+	}
+	var alignment Alignment
+	i, j := len(oldValues), len(newValues)
+	for i > 0 || j > 0 {
+		switch {
+		case i > 0 && j > 0 && fn(oldValues[i-1], newValues[j-1]) && table[i][j] == table[i-1][j-1]+1:
+			i, j = i-1, j-1
+			alignment = append(alignment, Edit{Kind: EditMatch, OldIndex: i, NewIndex: j})
+		case i > 0 && (j == 0 || table[i-1][j] >= table[i][j-1]):
+			i--
+			alignment = append(alignment, Edit{Kind: EditDelete, OldIndex: i, NewIndex: j})
+		default:
+			j--
+			alignment = append(alignment, Edit{Kind: EditInsert, OldIndex: i, NewIndex: j})
+		}
+	}
+	slices.Reverse(alignment)
+	return table[len(oldValues)][len(newValues)], alignment
+}
+
+// Levenshtein returns the minimum number of single value insertions, deletions and substitutions that turn slice a
+// into slice b and the alignment that achieves it.
+//
+//	a := &slice.Slice[rune]{'k', 'i', 't', 't', 'e', 'n'}
+//	b := &slice.Slice[rune]{'s', 'i', 't', 't', 'i', 'n', 'g'}
+//	distance, _ := slice.Levenshtein(a, b)
+//	fmt.Println(distance) // 3
+func Levenshtein[T comparable](a *Slice[T], b *Slice[T]) (int, Alignment) {
+	return LevenshteinFunc(a, b, equalComparable[T])
+}
+
+// LevenshteinFunc is like Levenshtein but uses the provided function to compare values.
+func LevenshteinFunc[T any](a *Slice[T], b *Slice[T], fn func(a T, b T) bool) (int, Alignment) {
+	return editDistance(*a, *b, fn, false)
+}
+
+// LongestCommonSubstring returns the length of the longest run of consecutive values shared by slices a and b and the
+// alignment of match edits for that run. If several runs have the same length, the one that ends first in a is returned.
+//
+//	a := &slice.Slice[int]{1, 2, 3, 4}
+//	b := &slice.Slice[int]{5, 2, 3, 6}
+//	length, alignment := slice.LongestCommonSubstring(a, b)
+//	fmt.Println(length, alignment[0].OldIndex, alignment[0].NewIndex) // 2, 1, 1
+func LongestCommonSubstring[T comparable](a *Slice[T], b *Slice[T]) (int, Alignment) {
+	return LongestCommonSubstringFunc(a, b, equalComparable[T])
+}
+
+// LongestCommonSubstringFunc is like LongestCommonSubstring but uses the provided function to compare values.
+func LongestCommonSubstringFunc[T any](a *Slice[T], b *Slice[T], fn func(a T, b T) bool) (int, Alignment) {
+	oldValues, newValues := *a, *b
+	// Only the previous row is needed: row[j] is the length of the run ending at oldValues[i-1] and newValues[j-1].
+	previous, current := make([]int, len(newValues)+1), make([]int, len(newValues)+1)
+	var length, oldEnd, newEnd int
+	for i := 1; i <= len(oldValues); i++ {
+		for j := 1; j <= len(newValues); j++ {
+			current[j] = 0
+			if fn(oldValues[i-1], newValues[j-1]) {
+				current[j] = previous[j-1] + 1
+				if current[j] > length {
+					length, oldEnd, newEnd = current[j], i, j
+				}
+			}
+		}
+		previous, current = current, previous
+	}
+	alignment := make(Alignment, length)
+	for k := range alignment {
+		alignment[k] = Edit{Kind: EditMatch, OldIndex: oldEnd - length + k, NewIndex: newEnd - length + k}
+	}
+	return length, alignment
+}
+
+// editDistance returns the Levenshtein distance between a and b, or the optimal string alignment distance if
+// transpositions are allowed, and the alignment that achieves it.
+func editDistance[T any](a []T, b []T, fn func(a T, b T) bool, transpositions bool) (int, Alignment) {
+	table := newDistanceTable(len(a), len(b))
+	for i := range table {
+		table[i][0] = i
+	}
+	for j := range table[0] {
+		table[0][j] = j
+	}
+	transposed := func(i int, j int) bool {
+		return transpositions && i > 1 && j > 1 && fn(a[i-1], b[j-2]) && fn(a[i-2], b[j-1])
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if fn(a[i-1], b[j-1]) {
+				cost = 0
+			}
+			table[i][j] = min(table[i-1][j]+1, table[i][j-1]+1, table[i-1][j-1]+cost)
+			if transposed(i, j) {
+				table[i][j] = min(table[i][j], table[i-2][j-2]+1)
+			}
+		}
+	}
+	var alignment Alignment
+	i, j := len(a), len(b)
+	for i > 0 || j > 0 {
+		switch {
+		case i > 0 && j > 0 && fn(a[i-1], b[j-1]) && table[i][j] == table[i-1][j-1]:
+			i, j = i-1, j-1
+			alignment = append(alignment, Edit{Kind: EditMatch, OldIndex: i, NewIndex: j})
+		case transposed(i, j) && table[i][j] == table[i-2][j-2]+1:
+			i, j = i-2, j-2
+			alignment = append(alignment, Edit{Kind: EditTranspose, OldIndex: i, NewIndex: j})
+		case i > 0 && j > 0 && table[i][j] == table[i-1][j-1]+1:
+			i, j = i-1, j-1
+			alignment = append(alignment, Edit{Kind: EditSubstitute, OldIndex: i, NewIndex: j})
+		case i > 0 && table[i][j] == table[i-1][j]+1:
+			i--
+			alignment = append(alignment, Edit{Kind: EditDelete, OldIndex: i, NewIndex: j})
+		default:
+			j--
+			alignment = append(alignment, Edit{Kind: EditInsert, OldIndex: i, NewIndex: j})
+		}
+	}
+	slices.Reverse(alignment)
+	return table[len(a)][len(b)], alignment
+}
+
+// newDistanceTable returns a zeroed (n+1) by (m+1) table backed by a single allocation.
+func newDistanceTable(n int, m int) [][]int {
+	cells := make([]int, (n+1)*(m+1))
+	table := make([][]int, n+1)
+	for i := range table {
+		table[i] = cells[i*(m+1) : (i+1)*(m+1)]
+	}
+	return table
+}
+
+// equalComparable reports whether a and b are equal using ==.
+func equalComparable[T comparable](a T, b T) bool {
+	return a == b
+}
@@ -0,0 +1,163 @@
+package slice_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/lindsaygelle/slice"
+)
+
+// checkAlignment verifies that the alignment walks both slices in order, that matched values are equal,
+// and returns the number of edits that are not matches.
+func checkAlignment(t *testing.T, a []rune, b []rune, alignment slice.Alignment) int {
+	t.Helper()
+	var i, j, cost int
+	for _, edit := range alignment {
+		if edit.OldIndex != i || edit.NewIndex != j {
+			t.Fatalf("Edit %v is out of order at (%d, %d)", edit, i, j)
+		}
+		switch edit.Kind {
+		case slice.EditMatch:
+			if a[i] != b[j] {
+				t.Fatalf("Edit %v matches %q with %q", edit, a[i], b[j])
+			}
+			i, j = i+1, j+1
+		case slice.EditSubstitute:
+			i, j, cost = i+1, j+1, cost+1
+		case slice.EditDelete:
+			i, cost = i+1, cost+1
+		case slice.EditInsert:
+			j, cost = j+1, cost+1
+		case slice.EditTranspose:
+			if a[i] != b[j+1] || a[i+1] != b[j] {
+				t.Fatalf("Edit %v does not transpose %q into %q", edit, a[i:i+2], b[j:j+2])
+			}
+			i, j, cost = i+2, j+2, cost+1
+		}
+	}
+	if i != len(a) || j != len(b) {
+		t.Fatalf("Alignment ends at (%d, %d), expected (%d, %d)", i, j, len(a), len(b))
+	}
+	return cost
+}
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"kitten", "sitting", 3},
+		{"", "abc", 3},
+		{"abc", "", 3},
+		{"abc", "abc", 0},
+		{"ab", "ba", 2},
+		{"flaw", "lawn", 2},
+	}
+	for _, test := range tests {
+		a, b := slice.Slice[rune](test.a), slice.Slice[rune](test.b)
+		distance, alignment := slice.Levenshtein(&a, &b)
+		if distance != test.expected {
+			t.Errorf("Levenshtein(%q, %q): expected %d, but got %d", test.a, test.b, test.expected, distance)
+		}
+		if cost := checkAlignment(t, a, b, alignment); cost != distance {
+			t.Errorf("Levenshtein(%q, %q): alignment costs %d, expected %d", test.a, test.b, cost, distance)
+		}
+	}
+}
+
+func TestDamerauLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"ab", "ba", 1},
+		{"abc", "acb", 1},
+		{"ca", "abc", 3}, // Optimal string alignment does not edit a transposed pair again.
+		{"kitten", "sitting", 3},
+		{"", "", 0},
+	}
+	for _, test := range tests {
+		a, b := slice.Slice[rune](test.a), slice.Slice[rune](test.b)
+		distance, alignment := slice.DamerauLevenshtein(&a, &b)
+		if distance != test.expected {
+			t.Errorf("DamerauLevenshtein(%q, %q): expected %d, but got %d", test.a, test.b, test.expected, distance)
+		}
+		if cost := checkAlignment(t, a, b, alignment); cost != distance {
+			t.Errorf("DamerauLevenshtein(%q, %q): alignment costs %d, expected %d", test.a, test.b, cost, distance)
+		}
+	}
+}
+
+func TestLCS(t *testing.T) {
+	// Test case 1: Known values.
+	a, b := slice.Slice[rune]("ABCBDAB"), slice.Slice[rune]("BDCABA")
+	length, alignment := slice.LCS(&a, &b)
+	if length != 4 {
+		t.Errorf("Expected 4, but got %d", length)
+	}
+	checkAlignment(t, a, b, alignment)
+
+	// Test case 2: The alignment has one match per common value and its cost relates to the length.
+	random := rand.New(rand.NewSource(1))
+	for iteration := 0; iteration < 100; iteration++ {
+		a, b := make(slice.Slice[rune], random.Intn(20)), make(slice.Slice[rune], random.Intn(20))
+		for i := range a {
+			a[i] = rune('a' + random.Intn(3))
+		}
+		for i := range b {
+			b[i] = rune('a' + random.Intn(3))
+		}
+		length, alignment := slice.LCS(&a, &b)
+		if cost := checkAlignment(t, a, b, alignment); cost != len(a)+len(b)-2*length {
+			t.Fatalf("LCS(%q, %q): alignment costs %d, expected %d", string(a), string(b), cost, len(a)+len(b)-2*length)
+		}
+	}
+}
+
+func TestLCSFunc(t *testing.T) {
+	a := &slice.Slice[string]{"Get", "Put", "Delete"}
+	b := &slice.Slice[string]{"get", "delete"}
+	length, _ := slice.LCSFunc(a, b, func(a string, b string) bool {
+		return len(a) == len(b) && a[1:] == b[1:]
+	})
+	if length != 2 {
+		t.Errorf("Expected 2, but got %d", length)
+	}
+}
+
+func TestLongestCommonSubstring(t *testing.T) {
+	a, b := slice.Slice[rune]("xabcdy"), slice.Slice[rune]("zzabcq")
+	length, alignment := slice.LongestCommonSubstring(&a, &b)
+	if length != 3 {
+		t.Errorf("Expected 3, but got %d", length)
+	}
+	expected := slice.Alignment{
+		{Kind: slice.EditMatch, OldIndex: 1, NewIndex: 2},
+		{Kind: slice.EditMatch, OldIndex: 2, NewIndex: 3},
+		{Kind: slice.EditMatch, OldIndex: 3, NewIndex: 4},
+	}
+	for k := range expected {
+		if k >= len(alignment) || alignment[k] != expected[k] {
+			t.Fatalf("Expected %v, but got %v", expected, alignment)
+		}
+	}
+
+	// Test case: No common values.
+	if length, alignment := slice.LongestCommonSubstring(&slice.Slice[int]{1}, &slice.Slice[int]{2}); length != 0 || len(alignment) != 0 {
+		t.Errorf("Expected 0 and an empty alignment, but got %d and %v", length, alignment)
+	}
+}
+
+func TestHamming(t *testing.T) {
+	a, b := slice.Slice[rune]("karolin"), slice.Slice[rune]("kathrin")
+	distance, alignment := slice.Hamming(&a, &b)
+	if distance != 3 {
+		t.Errorf("Expected 3, but got %d", distance)
+	}
+	checkAlignment(t, a, b, alignment)
+
+	// Test case: Different lengths.
+	if distance, alignment := slice.Hamming(&slice.Slice[int]{1}, &slice.Slice[int]{1, 2}); distance != -1 || alignment != nil {
+		t.Errorf("Expected -1 and a nil alignment, but got %d and %v", distance, alignment)
+	}
+}
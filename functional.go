@@ -0,0 +1,103 @@
+package slice
+
+// FlatMap applies the given function to each element of the slice and returns a new slice containing the concatenated results.
+// A nil result from the function contributes no elements.
+//
+//	newSlice := &slice.Slice[string]{"a,b", "c"}
+//	result := slice.FlatMap(newSlice, func(i int, value string) *slice.Slice[string] {
+//	    return slice.New(strings.Split(value, ",")...)
+//	})
+//	fmt.Println(result) // &[a, b, c]
+func FlatMap[T any, U any](slice *Slice[T], fn func(i int, value T) *Slice[U]) *Slice[U] {
+	newSlice := &Slice[U]{}
+	slice.Each(func(i int, value T) {
+		newSlice.Concatenate(fn(i, value))
+	})
+	return newSlice
+}
+
+// Fold applies the given function to each element of the slice, starting from the initial value, and returns the accumulated result.
+// Unlike Reduce, the result may have a different type than the elements of the slice.
+//
+//	newSlice := &slice.Slice[int]{1, 2, 3}
+//	result := slice.Fold(newSlice, "", func(i int, currentValue int, resultValue string) string {
+//	    return resultValue + strconv.Itoa(currentValue)
+//	})
+//	fmt.Println(result) // 123
+func Fold[T any, A any](slice *Slice[T], initialValue A, fn func(i int, currentValue T, resultValue A) A) A {
+	resultValue := initialValue
+	slice.Each(func(i int, currentValue T) {
+		resultValue = fn(i, currentValue, resultValue)
+	})
+	return resultValue
+}
+
+// FoldRight applies the given function to each element of the slice in reverse order, starting from the initial value, and returns the accumulated result.
+//
+//	newSlice := &slice.Slice[int]{1, 2, 3}
+//	result := slice.FoldRight(newSlice, "", func(i int, currentValue int, resultValue string) string {
+//	    return resultValue + strconv.Itoa(currentValue)
+//	})
+//	fmt.Println(result) // 321
+func FoldRight[T any, A any](slice *Slice[T], initialValue A, fn func(i int, currentValue T, resultValue A) A) A {
+	resultValue := initialValue
+	slice.EachReverse(func(i int, currentValue T) {
+		resultValue = fn(i, currentValue, resultValue)
+	})
+	return resultValue
+}
+
+// Map applies the given function to each element of the slice and returns a new slice with the results.
+// Unlike the Map method, the results may have a different type than the elements of the slice.
+//
+//	newSlice := &slice.Slice[int]{1, 2, 3}
+//	result := slice.Map(newSlice, func(i int, value int) string {
+//	    return strconv.Itoa(value * 2)
+//	})
+//	fmt.Println(result) // &[2, 4, 6]
+func Map[T any, U any](slice *Slice[T], fn func(i int, value T) U) *Slice[U] {
+	newSlice := make(Slice[U], slice.Length())
+	slice.Each(func(i int, value T) {
+		newSlice[i] = fn(i, value)
+	})
+	return &newSlice
+}
+
+// MapErr applies the given function to each element of the slice and returns a new slice with the results.
+// It stops at the first error returned by the function and returns nil and that error.
+//
+//	newSlice := &slice.Slice[string]{"1", "2", "x"}
+//	result, err := slice.MapErr(newSlice, func(i int, value string) (int, error) {
+//	    return strconv.Atoi(value)
+//	})
+//	fmt.Println(result, err) // <nil>, strconv.Atoi: parsing "x": invalid syntax
+func MapErr[T any, U any](slice *Slice[T], fn func(i int, value T) (U, error)) (*Slice[U], error) {
+	newSlice := make(Slice[U], slice.Length())
+	var err error
+	slice.EachBreak(func(i int, value T) bool {
+		newSlice[i], err = fn(i, value)
+		return err == nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &newSlice, nil
+}
+
+// Scan applies the given function to each element of the slice, starting from the initial value,
+// and returns a new slice containing every intermediate result.
+//
+//	newSlice := &slice.Slice[int]{1, 2, 3, 4}
+//	result := slice.Scan(newSlice, 0, func(i int, currentValue int, resultValue int) int {
+//	    return resultValue + currentValue
+//	})
+//	fmt.Println(result) // &[1, 3, 6, 10]
+func Scan[T any, A any](slice *Slice[T], initialValue A, fn func(i int, currentValue T, resultValue A) A) *Slice[A] {
+	newSlice := make(Slice[A], slice.Length())
+	resultValue := initialValue
+	slice.Each(func(i int, currentValue T) {
+		resultValue = fn(i, currentValue, resultValue)
+		newSlice[i] = resultValue
+	})
+	return &newSlice
+}
@@ -0,0 +1,110 @@
+package slice_test
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/lindsaygelle/slice"
+)
+
+func TestFlatMap(t *testing.T) {
+	// Test case: Expand each element into several elements of a different type.
+	s := &slice.Slice[string]{"a,b", "", "c"}
+	result := slice.FlatMap(s, func(i int, value string) *slice.Slice[string] {
+		if value == "" {
+			return nil
+		}
+		return slice.New(strings.Split(value, ",")...)
+	})
+
+	expected := &slice.Slice[string]{"a", "b", "c"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+}
+
+func TestFold(t *testing.T) {
+	// Test case 1: Fold elements into a value of a different type.
+	s := &slice.Slice[int]{1, 2, 3}
+	result := slice.Fold(s, "", func(i int, currentValue int, resultValue string) string {
+		return resultValue + strconv.Itoa(currentValue)
+	})
+	if result != "123" {
+		t.Errorf("Expected %v, but got %v", "123", result)
+	}
+
+	// Test case 2: Fold an empty slice.
+	s = &slice.Slice[int]{}
+	result = slice.Fold(s, "init", func(i int, currentValue int, resultValue string) string {
+		return resultValue + strconv.Itoa(currentValue)
+	})
+	if result != "init" {
+		t.Errorf("Expected %v, but got %v", "init", result)
+	}
+}
+
+func TestFoldRight(t *testing.T) {
+	// Test case: Fold elements in reverse order.
+	s := &slice.Slice[int]{1, 2, 3}
+	result := slice.FoldRight(s, "", func(i int, currentValue int, resultValue string) string {
+		return resultValue + strconv.Itoa(currentValue)
+	})
+	if result != "321" {
+		t.Errorf("Expected %v, but got %v", "321", result)
+	}
+}
+
+func TestMapFunction(t *testing.T) {
+	// Test case: Map elements to a different type.
+	s := &slice.Slice[int]{1, 2, 3}
+	result := slice.Map(s, func(i int, value int) string {
+		return strconv.Itoa(i) + ":" + strconv.Itoa(value)
+	})
+
+	expected := &slice.Slice[string]{"0:1", "1:2", "2:3"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+}
+
+func TestMapErr(t *testing.T) {
+	// Test case 1: Map all elements successfully.
+	s := &slice.Slice[string]{"1", "2", "3"}
+	result, err := slice.MapErr(s, func(i int, value string) (int, error) {
+		return strconv.Atoi(value)
+	})
+
+	expected := &slice.Slice[int]{1, 2, 3}
+	if err != nil || !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v and no error, but got %v and %v", expected, result, err)
+	}
+
+	// Test case 2: Stop at the first error.
+	s = &slice.Slice[string]{"1", "x", "y"}
+	var calls int
+	result, err = slice.MapErr(s, func(i int, value string) (int, error) {
+		calls++
+		return strconv.Atoi(value)
+	})
+	if err == nil || result != nil {
+		t.Errorf("Expected nil and an error, but got %v and %v", result, err)
+	}
+	if calls != 2 {
+		t.Errorf("Expected 2 calls, but got %d", calls)
+	}
+}
+
+func TestScan(t *testing.T) {
+	// Test case: Compute running totals.
+	s := &slice.Slice[int]{1, 2, 3, 4}
+	result := slice.Scan(s, 0, func(i int, currentValue int, resultValue int) int {
+		return resultValue + currentValue
+	})
+
+	expected := &slice.Slice[int]{1, 3, 6, 10}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+}
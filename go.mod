@@ -1,6 +1,6 @@
 module github.com/lindsaygelle/slice
 
-go 1.21
+go 1.23
 
 retract (
     [v1.0.0, v1.2.1]
@@ -0,0 +1,141 @@
+package slice
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrDuplicateKey is returned by IndexBy when two elements have the same key and the policy is DuplicateKeyError.
+var ErrDuplicateKey = errors.New("slice: duplicate key")
+
+// DuplicateKeyPolicy decides what IndexBy does when two elements have the same key.
+type DuplicateKeyPolicy int
+
+const (
+	// DuplicateKeyError stops and returns an error wrapping ErrDuplicateKey.
+	DuplicateKeyError DuplicateKeyPolicy = iota
+	// DuplicateKeyKeepFirst keeps the first element with the key.
+	DuplicateKeyKeepFirst
+	// DuplicateKeyKeepLast keeps the last element with the key.
+	DuplicateKeyKeepLast
+)
+
+// Group is a key and the elements that have that key, in the order they appear in the slice.
+type Group[K comparable, T any] struct {
+	Key    K
+	Values *Slice[T]
+}
+
+// CountBy returns the number of elements of the slice for each key returned by the provided function.
+//
+//	newSlice := &slice.Slice[string]{"apple", "avocado", "banana"}
+//	counts := slice.CountBy(newSlice, func(value string) byte {
+//	    return value[0]
+//	})
+//	fmt.Println(counts['a'], counts['b']) // 2, 1
+func CountBy[T any, K comparable](slice *Slice[T], key func(value T) K) map[K]int {
+	counts := make(map[K]int)
+	for _, value := range *slice {
+		counts[key(value)]++
+	}
+	return counts
+}
+
+// GroupBy returns the elements of the slice grouped by the key returned by the provided function.
+// The elements of each group are in the order they appear in the slice.
+//
+//	newSlice := &slice.Slice[int]{1, 2, 3, 4, 5}
+//	groups := slice.GroupBy(newSlice, func(value int) bool {
+//	    return value%2 == 0
+//	})
+//	fmt.Println(groups[true], groups[false]) // &[2, 4], &[1, 3, 5]
+func GroupBy[T any, K comparable](slice *Slice[T], key func(value T) K) map[K]*Slice[T] {
+	groups := make(map[K]*Slice[T])
+	for _, value := range *slice {
+		k := key(value)
+		group, ok := groups[k]
+		if !ok {
+			group = &Slice[T]{}
+			groups[k] = group
+		}
+		group.Append(value)
+	}
+	return groups
+}
+
+// GroupByOrdered is like GroupBy but returns the groups in the order their keys first appear in the slice.
+//
+//	newSlice := &slice.Slice[string]{"bob", "ann", "bill"}
+//	groups := slice.GroupByOrdered(newSlice, func(value string) byte {
+//	    return value[0]
+//	})
+//	fmt.Println(groups.Fetch(0).Values, groups.Fetch(1).Values) // &[bob, bill], &[ann]
+func GroupByOrdered[T any, K comparable](slice *Slice[T], key func(value T) K) *Slice[Group[K, T]] {
+	indices := make(map[K]int)
+	groups := &Slice[Group[K, T]]{}
+	for _, value := range *slice {
+		k := key(value)
+		i, ok := indices[k]
+		if !ok {
+			i = groups.Length()
+			indices[k] = i
+			groups.Append(Group[K, T]{Key: k, Values: &Slice[T]{}})
+		}
+		(*groups)[i].Values.Append(value)
+	}
+	return groups
+}
+
+// IndexBy returns a map from the key returned by the provided function to the element with that key.
+// The policy decides what happens when two elements have the same key. With DuplicateKeyError,
+// IndexBy returns nil and an error wrapping ErrDuplicateKey at the first duplicate.
+//
+//	newSlice := &slice.Slice[string]{"ann", "bob"}
+//	index, err := slice.IndexBy(newSlice, func(value string) byte {
+//	    return value[0]
+//	}, slice.DuplicateKeyError)
+//	fmt.Println(index['b'], err) // bob, <nil>
+func IndexBy[T any, K comparable](slice *Slice[T], key func(value T) K, policy DuplicateKeyPolicy) (map[K]T, error) {
+	index := make(map[K]T, len(*slice))
+	for i, value := range *slice {
+		k := key(value)
+		if _, ok := index[k]; ok {
+			switch policy {
+			case DuplicateKeyKeepFirst:
+				continue
+			case DuplicateKeyError:
+				return nil, fmt.Errorf("%w: %v at index %d", ErrDuplicateKey, k, i)
+			}
+		}
+		index[k] = value
+	}
+	return index, nil
+}
+
+// PartitionN distributes the elements of the slice into n buckets and returns the buckets in order.
+// The provided function returns the bucket of each element; values outside [0, n) are wrapped modulo n,
+// so a hash can be returned directly. The elements of each bucket keep their order.
+// The returned slice is empty if n is less than 1.
+//
+//	newSlice := &slice.Slice[int]{1, 5, 12, 30}
+//	buckets := slice.PartitionN(newSlice, 3, func(value int) int {
+//	    return min(value/10, 2)
+//	})
+//	fmt.Println(buckets.Fetch(0), buckets.Fetch(1), buckets.Fetch(2)) // &[1, 5], &[12], &[30]
+func PartitionN[T any](slice *Slice[T], n int, fn func(value T) int) *Slice[*Slice[T]] {
+	if n < 1 {
+		return &Slice[*Slice[T]]{}
+	}
+	buckets := make(Slice[*Slice[T]], n)
+	for i := range buckets {
+		buckets[i] = &Slice[T]{}
+	}
+	for _, value := range *slice {
+		bucket := fn(value) % n
+		if bucket < 0 {
+			bucket += n
+		}
+		buckets[bucket].Append(value)
+	}
+	return &buckets
+}
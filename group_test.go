@@ -0,0 +1,108 @@
+package slice_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/lindsaygelle/slice"
+)
+
+type event struct {
+	User string
+	ID   int
+}
+
+func TestGroupBy(t *testing.T) {
+	events := &slice.Slice[event]{{"ann", 1}, {"bob", 2}, {"ann", 3}}
+	groups := slice.GroupBy(events, func(value event) string {
+		return value.User
+	})
+
+	expected := map[string]*slice.Slice[event]{
+		"ann": {{"ann", 1}, {"ann", 3}},
+		"bob": {{"bob", 2}},
+	}
+	if !reflect.DeepEqual(groups, expected) {
+		t.Errorf("Expected %v, but got %v", expected, groups)
+	}
+}
+
+func TestGroupByOrdered(t *testing.T) {
+	events := &slice.Slice[event]{{"bob", 1}, {"ann", 2}, {"bob", 3}}
+	groups := slice.GroupByOrdered(events, func(value event) string {
+		return value.User
+	})
+
+	expected := &slice.Slice[slice.Group[string, event]]{
+		{Key: "bob", Values: &slice.Slice[event]{{"bob", 1}, {"bob", 3}}},
+		{Key: "ann", Values: &slice.Slice[event]{{"ann", 2}}},
+	}
+	if !reflect.DeepEqual(groups, expected) {
+		t.Errorf("Expected %v, but got %v", expected, groups)
+	}
+}
+
+func TestCountBy(t *testing.T) {
+	newSlice := &slice.Slice[int]{1, 2, 3, 4, 5}
+	counts := slice.CountBy(newSlice, func(value int) bool {
+		return value%2 == 0
+	})
+
+	expected := map[bool]int{true: 2, false: 3}
+	if !reflect.DeepEqual(counts, expected) {
+		t.Errorf("Expected %v, but got %v", expected, counts)
+	}
+}
+
+func TestIndexBy(t *testing.T) {
+	events := &slice.Slice[event]{{"ann", 1}, {"bob", 2}, {"ann", 3}}
+	key := func(value event) string {
+		return value.User
+	}
+
+	// Test case 1: Keep the first element for a duplicate key.
+	index, err := slice.IndexBy(events, key, slice.DuplicateKeyKeepFirst)
+	if err != nil || index["ann"].ID != 1 || index["bob"].ID != 2 {
+		t.Errorf("Expected ann to map to 1, but got %v (%v)", index, err)
+	}
+
+	// Test case 2: Keep the last element for a duplicate key.
+	index, err = slice.IndexBy(events, key, slice.DuplicateKeyKeepLast)
+	if err != nil || index["ann"].ID != 3 {
+		t.Errorf("Expected ann to map to 3, but got %v (%v)", index, err)
+	}
+
+	// Test case 3: Report a duplicate key.
+	index, err = slice.IndexBy(events, key, slice.DuplicateKeyError)
+	if !errors.Is(err, slice.ErrDuplicateKey) || index != nil {
+		t.Errorf("Expected ErrDuplicateKey, but got %v (%v)", index, err)
+	}
+}
+
+func TestPartitionN(t *testing.T) {
+	newSlice := &slice.Slice[int]{-4, -1, 0, 1, 2, 3, 7}
+
+	// Test case 1: Values outside the range wrap modulo n.
+	buckets := slice.PartitionN(newSlice, 3, func(value int) int {
+		return value
+	})
+	expected := &slice.Slice[*slice.Slice[int]]{{0, 3}, {1, 7}, {-4, -1, 2}}
+	if !reflect.DeepEqual(buckets, expected) {
+		t.Errorf("Expected %v, but got %v", expected, buckets)
+	}
+
+	// Test case 2: Empty buckets are present.
+	buckets = slice.PartitionN(&slice.Slice[int]{1}, 2, func(value int) int {
+		return 1
+	})
+	expected = &slice.Slice[*slice.Slice[int]]{{}, {1}}
+	if !reflect.DeepEqual(buckets, expected) {
+		t.Errorf("Expected %v, but got %v", expected, buckets)
+	}
+
+	// Test case 3: No buckets.
+	if buckets := slice.PartitionN(newSlice, 0, func(value int) int { return value }); buckets.Length() != 0 {
+		t.Errorf("Expected no buckets, but got %v", buckets)
+	}
+}
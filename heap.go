@@ -0,0 +1,296 @@
+package slice
+
+import (
+	"cmp"
+	"container/heap"
+	"fmt"
+)
+
+// PriorityQueue represents a generic d-ary heap built on a Slice[T].
+// The value for which less reports true against every other value has the highest priority,
+// so a less function of a < b gives a min-heap and a > b gives a max-heap.
+// Push and PopMin run in O(log n) time, and Peek runs in O(1) time.
+//
+// Fix, Remove and Update take the index of a value in the heap, which changes as values move.
+// Use OnSwap to keep track of the index of each value.
+//
+// Use NewPriorityQueue, NewDaryPriorityQueue, NewMinHeap, NewMaxHeap or HeapifySlice to create a PriorityQueue.
+type PriorityQueue[T any] struct {
+	slice  *Slice[T]
+	less   func(a T, b T) bool
+	arity  int
+	onSwap func(i int, value T)
+}
+
+// Fix restores the heap ordering after the value at index i has been changed in place,
+// and returns true if the index is valid, or false otherwise.
+//
+//	queue := slice.NewPriorityQueue(func(a, b *Task) bool {
+//	    return a.Priority < b.Priority
+//	}, tasks...)
+//	queue.OnSwap(func(i int, task *Task) {
+//	    task.Index = i
+//	})
+//	tasks[3].Priority = 0
+//	queue.Fix(tasks[3].Index)
+func (queue *PriorityQueue[T]) Fix(i int) bool {
+	if !queue.slice.Bounds(i) {
+		return false
+	}
+	if !queue.down(i) {
+		queue.up(i)
+	}
+	return true
+}
+
+// Interface returns an adapter that implements heap.Interface over the same storage, for use with the container/heap package.
+// The container/heap functions maintain a binary heap, so Interface panics if the queue has an arity other than 2.
+//
+//	queue := slice.NewMinHeap[int](3, 1, 2)
+//	heap.Push(queue.Interface(), 0)
+//	value, _ := queue.Peek()
+//	fmt.Println(value) // 0
+func (queue *PriorityQueue[T]) Interface() heap.Interface {
+	if queue.arity != 2 {
+		panic(fmt.Sprintf("slice: Interface requires a binary PriorityQueue, but the arity is %d", queue.arity))
+	}
+	return &heapInterface[T]{queue: queue}
+}
+
+// IsEmpty returns true if the queue is empty, or false otherwise.
+func (queue *PriorityQueue[T]) IsEmpty() bool {
+	return queue.slice.IsEmpty()
+}
+
+// Length returns the number of values in the queue.
+func (queue *PriorityQueue[T]) Length() int {
+	return queue.slice.Length()
+}
+
+// OnSwap registers a function that is called with the new index of each value the queue moves, and returns the queue.
+// It is called for every value in the queue when it is registered, for values added by Push and Update,
+// and with an index of -1 for values removed by PopMin and Remove or replaced by Update, like the index field kept by the
+// priority queue example of container/heap. Passing nil removes the function.
+//
+//	queue := slice.NewPriorityQueue(func(a, b *Task) bool {
+//	    return a.Priority < b.Priority
+//	}, tasks...)
+//	queue.OnSwap(func(i int, task *Task) {
+//	    task.Index = i
+//	})
+//	queue.Remove(tasks[0].Index)
+func (queue *PriorityQueue[T]) OnSwap(fn func(i int, value T)) *PriorityQueue[T] {
+	queue.onSwap = fn
+	for i := range *queue.slice {
+		queue.moved(i)
+	}
+	return queue
+}
+
+// Peek returns the value with the highest priority and true without removing it, or a zero value and false if the queue is empty.
+//
+//	queue := slice.NewMinHeap[int](3, 1, 2)
+//	value, ok := queue.Peek()
+//	fmt.Println(value, ok) // 1, true
+func (queue *PriorityQueue[T]) Peek() (T, bool) {
+	return queue.slice.Get(0)
+}
+
+// PopMin removes and returns the value with the highest priority and true, or a zero value and false if the queue is empty.
+// For a max-heap this is the largest value.
+//
+//	queue := slice.NewMinHeap[int](3, 1, 2)
+//	value, ok := queue.PopMin()
+//	fmt.Println(value, ok) // 1, true
+func (queue *PriorityQueue[T]) PopMin() (T, bool) {
+	return queue.Remove(0)
+}
+
+// Push adds the given values to the queue and returns the queue.
+//
+//	queue := slice.NewMinHeap[int]()
+//	queue.Push(3, 1, 2)
+//	value, _ := queue.Peek()
+//	fmt.Println(value) // 1
+func (queue *PriorityQueue[T]) Push(values ...T) *PriorityQueue[T] {
+	for _, value := range values {
+		queue.slice.Append(value)
+		queue.moved(queue.slice.Length() - 1)
+		queue.up(queue.slice.Length() - 1)
+	}
+	return queue
+}
+
+// Remove removes and returns the value at index i and true, or a zero value and false if the index is out of bounds.
+//
+//	queue := slice.NewMinHeap[int](1, 2, 3)
+//	value, ok := queue.Remove(2)
+//	fmt.Println(value, ok) // 3, true
+func (queue *PriorityQueue[T]) Remove(i int) (T, bool) {
+	var value T
+	if !queue.slice.Bounds(i) {
+		return value, false
+	}
+	last := queue.slice.Length() - 1
+	queue.swap(i, last)
+	value = queue.slice.Pop()
+	if queue.onSwap != nil {
+		queue.onSwap(-1, value)
+	}
+	if i < last {
+		queue.Fix(i)
+	}
+	return value, true
+}
+
+// ToSlice returns a new slice containing the values of the queue in heap order.
+//
+//	queue := slice.NewMinHeap[int](3, 1, 2)
+//	fmt.Println(queue.ToSlice()) // &[1, 3, 2]
+func (queue *PriorityQueue[T]) ToSlice() *Slice[T] {
+	return New(*queue.slice...)
+}
+
+// Update replaces the value at index i, restores the heap ordering and returns true if the index is valid, or false otherwise.
+//
+//	queue := slice.NewMinHeap[int](1, 2, 3)
+//	queue.Update(2, 0)
+//	value, _ := queue.Peek()
+//	fmt.Println(value) // 0
+func (queue *PriorityQueue[T]) Update(i int, value T) bool {
+	oldValue, ok := queue.slice.Get(i)
+	if !ok {
+		return false
+	}
+	queue.slice.Replace(i, value)
+	if queue.onSwap != nil {
+		queue.onSwap(-1, oldValue)
+	}
+	queue.moved(i)
+	return queue.Fix(i)
+}
+
+// down moves the value at index i towards the leaves and returns true if it moved.
+func (queue *PriorityQueue[T]) down(i int) bool {
+	start := i
+	length := queue.slice.Length()
+	for {
+		smallest := i
+		for child := queue.arity*i + 1; child <= queue.arity*i+queue.arity && child < length; child++ {
+			if queue.less((*queue.slice)[child], (*queue.slice)[smallest]) {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			return i > start
+		}
+		queue.swap(i, smallest)
+		i = smallest
+	}
+}
+
+// heapify establishes the heap ordering over the whole slice.
+func (queue *PriorityQueue[T]) heapify() *PriorityQueue[T] {
+	for i := (queue.slice.Length() - 2) / queue.arity; i >= 0; i-- {
+		queue.down(i)
+	}
+	return queue
+}
+
+// moved reports the index of the value at index i to the OnSwap function, if there is one.
+func (queue *PriorityQueue[T]) moved(i int) {
+	if queue.onSwap != nil {
+		queue.onSwap(i, (*queue.slice)[i])
+	}
+}
+
+// swap swaps the values at indices i and j and reports their new indices.
+func (queue *PriorityQueue[T]) swap(i int, j int) {
+	queue.slice.Swap(i, j)
+	queue.moved(i)
+	queue.moved(j)
+}
+
+// up moves the value at index i towards the root.
+func (queue *PriorityQueue[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / queue.arity
+		if !queue.less((*queue.slice)[i], (*queue.slice)[parent]) {
+			return
+		}
+		queue.swap(i, parent)
+		i = parent
+	}
+}
+
+// heapInterface adapts a PriorityQueue to heap.Interface.
+type heapInterface[T any] struct {
+	queue *PriorityQueue[T]
+}
+
+// Len implements sort.Interface.
+func (adapter *heapInterface[T]) Len() int {
+	return adapter.queue.slice.Length()
+}
+
+// Less implements sort.Interface.
+func (adapter *heapInterface[T]) Less(i int, j int) bool {
+	return adapter.queue.less((*adapter.queue.slice)[i], (*adapter.queue.slice)[j])
+}
+
+// Pop implements heap.Interface.
+func (adapter *heapInterface[T]) Pop() any {
+	value := adapter.queue.slice.Pop()
+	if adapter.queue.onSwap != nil {
+		adapter.queue.onSwap(-1, value)
+	}
+	return value
+}
+
+// Push implements heap.Interface.
+func (adapter *heapInterface[T]) Push(value any) {
+	adapter.queue.slice.Append(value.(T))
+	adapter.queue.moved(adapter.queue.slice.Length() - 1)
+}
+
+// Swap implements sort.Interface.
+func (adapter *heapInterface[T]) Swap(i int, j int) {
+	adapter.queue.swap(i, j)
+}
+
+// HeapifySlice creates a new binary PriorityQueue that uses the given slice as its storage and orders it in place in O(n) time.
+// The slice must not be modified directly while the queue is in use.
+//
+//	newSlice := slice.New[int](3, 1, 2)
+//	queue := slice.HeapifySlice(newSlice, func(a, b int) bool {
+//	    return a < b
+//	})
+//	fmt.Println(newSlice) // &[1, 3, 2]
+func HeapifySlice[T any](slice *Slice[T], less func(a T, b T) bool) *PriorityQueue[T] {
+	return (&PriorityQueue[T]{slice: slice, less: less, arity: 2}).heapify()
+}
+
+// NewDaryPriorityQueue creates a new instance of the PriorityQueue[T] type in which every node has up to arity children,
+// and initializes it with the provided values. An arity below 2 is treated as 2.
+// Higher arities make Push cheaper and PopMin more expensive.
+func NewDaryPriorityQueue[T any](arity int, less func(a T, b T) bool, values ...T) *PriorityQueue[T] {
+	return (&PriorityQueue[T]{slice: New(values...), less: less, arity: max(arity, 2)}).heapify()
+}
+
+// NewMaxHeap creates a new binary PriorityQueue in which the largest value has the highest priority and initializes it with the provided values.
+func NewMaxHeap[T cmp.Ordered](values ...T) *PriorityQueue[T] {
+	return NewPriorityQueue(func(a T, b T) bool {
+		return cmp.Less(b, a)
+	}, values...)
+}
+
+// NewMinHeap creates a new binary PriorityQueue in which the smallest value has the highest priority and initializes it with the provided values.
+func NewMinHeap[T cmp.Ordered](values ...T) *PriorityQueue[T] {
+	return NewPriorityQueue(cmp.Less[T], values...)
+}
+
+// NewPriorityQueue creates a new instance of the binary PriorityQueue[T] type ordered by the given less function
+// and initializes it with the provided values.
+func NewPriorityQueue[T any](less func(a T, b T) bool, values ...T) *PriorityQueue[T] {
+	return NewDaryPriorityQueue(2, less, values...)
+}
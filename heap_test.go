@@ -0,0 +1,236 @@
+package slice_test
+
+import (
+	"container/heap"
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/lindsaygelle/slice"
+)
+
+// drain pops every value from the queue in priority order.
+func drain[T any](queue *slice.PriorityQueue[T]) []T {
+	var values []T
+	for {
+		value, ok := queue.PopMin()
+		if !ok {
+			return values
+		}
+		values = append(values, value)
+	}
+}
+
+func TestPriorityQueueFix(t *testing.T) {
+	// Test case: Restore the ordering after changing a value in place.
+	type task struct{ priority, index int }
+	tasks := []*task{{priority: 5}, {priority: 3}, {priority: 8}, {priority: 1}}
+	queue := slice.NewPriorityQueue(func(a *task, b *task) bool {
+		return a.priority < b.priority
+	}, tasks...)
+	queue.OnSwap(func(i int, value *task) {
+		value.index = i
+	})
+	tasks[2].priority = 0
+	if !queue.Fix(tasks[2].index) {
+		t.Errorf("Expected true, but got false")
+	}
+	if value, _ := queue.Peek(); value != tasks[2] {
+		t.Errorf("Expected %v, but got %v", tasks[2], value)
+	}
+	if queue.Fix(10) {
+		t.Errorf("Expected false, but got true")
+	}
+}
+
+func TestPriorityQueueInterface(t *testing.T) {
+	// Test case: Use the queue with the container/heap package.
+	queue := slice.NewMinHeap[int](5, 3, 8)
+	heap.Push(queue.Interface(), 1)
+	if value := heap.Pop(queue.Interface()); value != 1 {
+		t.Errorf("Expected 1, but got %v", value)
+	}
+
+	expected := []int{3, 5, 8}
+	if result := drain(queue); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+
+	// Test case 2: Interface panics for a queue that is not binary.
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected a panic, but got none")
+		}
+	}()
+	slice.NewDaryPriorityQueue(4, func(a int, b int) bool {
+		return a < b
+	}).Interface()
+}
+
+func TestPriorityQueueOnSwap(t *testing.T) {
+	// Test case: The indices reported to OnSwap always match the heap, whatever the queue does.
+	type item struct{ value, index int }
+	random := rand.New(rand.NewSource(1))
+	var items []*item
+	for i := 0; i < 50; i++ {
+		items = append(items, &item{value: random.Intn(1000)})
+	}
+	queue := slice.NewDaryPriorityQueue(3, func(a *item, b *item) bool {
+		return a.value < b.value
+	}, items...)
+	queue.OnSwap(func(i int, value *item) {
+		value.index = i
+	})
+	live := slices.Clone(items)
+	for step := 0; step < 2000 && len(live) > 0; step++ {
+		target := live[random.Intn(len(live))]
+		switch random.Intn(4) {
+		case 0:
+			newItem := &item{value: random.Intn(1000)}
+			queue.Push(newItem)
+			live = append(live, newItem)
+		case 1:
+			target.value = random.Intn(1000)
+			queue.Fix(target.index)
+		case 2:
+			queue.Update(target.index, &item{value: random.Intn(1000)})
+			if target.index != -1 {
+				t.Fatalf("Expected the replaced value to report -1, but got %d", target.index)
+			}
+		default:
+			value, _ := queue.Remove(target.index)
+			if value != target || target.index != -1 {
+				t.Fatalf("Expected to remove the target and report -1, but got index %d", target.index)
+			}
+		}
+		heapValues := *queue.ToSlice()
+		live = live[:0]
+		for i, value := range heapValues {
+			if value.index != i {
+				t.Fatalf("Expected index %d, but the value reports %d", i, value.index)
+			}
+			live = append(live, value)
+		}
+	}
+}
+
+func TestPriorityQueuePopMin(t *testing.T) {
+	// Test case 1: Pop values from a min-heap in ascending order.
+	queue := slice.NewMinHeap[int](5, 3, 8, 1, 9, 2)
+
+	expected := []int{1, 2, 3, 5, 8, 9}
+	if result := drain(queue); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+
+	// Test case 2: Pop values from a max-heap in descending order.
+	queue = slice.NewMaxHeap[int](5, 3, 8, 1, 9, 2)
+
+	expected = []int{9, 8, 5, 3, 2, 1}
+	if result := drain(queue); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+
+	// Test case 3: Pop values from d-ary heaps of several arities.
+	values := make([]int, 500)
+	for i := range values {
+		values[i] = rand.Intn(100)
+	}
+	expected = slices.Sorted(slices.Values(values))
+	for arity := 1; arity <= 5; arity++ {
+		queue = slice.NewDaryPriorityQueue(arity, func(a int, b int) bool {
+			return a < b
+		}, values[:250]...)
+		queue.Push(values[250:]...)
+		if result := drain(queue); !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected sorted values for arity %d, but got %v", arity, result)
+		}
+	}
+
+	// Test case 4: Pop from an empty queue.
+	if _, ok := queue.PopMin(); ok {
+		t.Errorf("Expected false, but got true")
+	}
+}
+
+func TestPriorityQueuePush(t *testing.T) {
+	// Test case: Push values and peek at the highest priority value.
+	queue := slice.NewMinHeap[int]()
+	if _, ok := queue.Peek(); ok {
+		t.Errorf("Expected false, but got true")
+	}
+	queue.Push(3, 1, 2)
+	if value, ok := queue.Peek(); !ok || value != 1 {
+		t.Errorf("Expected 1 and true, but got %d and %v", value, ok)
+	}
+	if length := queue.Length(); length != 3 {
+		t.Errorf("Expected length 3, but got %d", length)
+	}
+}
+
+func TestPriorityQueueRemove(t *testing.T) {
+	// Test case 1: Remove values at arbitrary indices.
+	queue := slice.NewMinHeap[int](5, 3, 8, 1, 9, 2, 7)
+	removed := map[int]bool{}
+	for _, i := range []int{3, 0, 2} {
+		value, ok := queue.Remove(i)
+		if !ok {
+			t.Errorf("Expected true, but got false")
+		}
+		removed[value] = true
+	}
+	var expected []int
+	for _, value := range []int{1, 2, 3, 5, 7, 8, 9} {
+		if !removed[value] {
+			expected = append(expected, value)
+		}
+	}
+	if result := drain(queue); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+
+	// Test case 2: Remove a value out of bounds.
+	if _, ok := queue.Remove(0); ok {
+		t.Errorf("Expected false, but got true")
+	}
+}
+
+func TestPriorityQueueUpdate(t *testing.T) {
+	// Test case 1: Decrease a value, then increase the value that moved to the root.
+	queue := slice.NewMinHeap[int](1, 2, 3, 4, 5)
+	queue.Update(4, 0)
+	if value, _ := queue.Peek(); value != 0 {
+		t.Errorf("Expected 0, but got %d", value)
+	}
+	queue.Update(0, 10)
+
+	expected := []int{1, 2, 3, 4, 10}
+	if result := drain(queue); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+
+	// Test case 2: Update a value out of bounds.
+	if queue.Update(0, 1) {
+		t.Errorf("Expected false, but got true")
+	}
+}
+
+func TestHeapifySlice(t *testing.T) {
+	// Test case: Order an existing slice in place.
+	s := slice.New[int](3, 1, 2)
+	queue := slice.HeapifySlice(s, func(a int, b int) bool {
+		return a < b
+	})
+
+	expected := &slice.Slice[int]{1, 3, 2}
+	if !reflect.DeepEqual(s, expected) {
+		t.Errorf("Expected %v, but got %v", expected, s)
+	}
+	if value, _ := queue.PopMin(); value != 1 {
+		t.Errorf("Expected 1, but got %d", value)
+	}
+	if length := s.Length(); length != 2 {
+		t.Errorf("Expected length 2, but got %d", length)
+	}
+}
@@ -0,0 +1,331 @@
+package slice
+
+import (
+	"slices"
+	"sort"
+)
+
+// HistorySlice represents a generic slice that records its mutations so they can be undone and redone.
+// Each mutation is stored as a compact reversible operation rather than a copy of the slice:
+// Append, Prepend, Delete and Replace store only the values they add or remove, Swap and Reverse store
+// nothing extra, Splice stores the values it drops and SortFunc stores the permutation it applied.
+//
+// A new mutation clears the redo history and every checkpoint that was recorded after the current position.
+// When a limit is set, the oldest operations (and the checkpoints that refer to them) are discarded once
+// the undo history grows past it.
+//
+// The zero value is an empty slice with unlimited history ready to use.
+type HistorySlice[T any] struct {
+	slice       Slice[T]
+	undo        []historyOperation[T]
+	redo        []historyOperation[T]
+	limit       int
+	discarded   int
+	checkpoints map[string]int
+}
+
+// Append adds the given values to the end of the slice, records the change and returns the slice.
+//
+//	newSlice := slice.NewHistory[int](0)
+//	newSlice.Append(1, 2)
+//	newSlice.Undo()
+//	fmt.Println(newSlice.ToSlice()) // &[]
+func (history *HistorySlice[T]) Append(values ...T) *HistorySlice[T] {
+	if len(values) > 0 {
+		history.record(historyOperation[T]{kind: historyAppend, values: slices.Clone(values)})
+	}
+	return history
+}
+
+// Checkpoint records the current position of the history under the given label, replacing any earlier checkpoint with the same label.
+//
+//	newSlice := slice.NewHistory[int](0, 1)
+//	newSlice.Checkpoint("start")
+//	newSlice.Append(2).Append(3)
+//	newSlice.RevertTo("start")
+//	fmt.Println(newSlice.ToSlice()) // &[1]
+func (history *HistorySlice[T]) Checkpoint(label string) *HistorySlice[T] {
+	if history.checkpoints == nil {
+		history.checkpoints = make(map[string]int)
+	}
+	history.checkpoints[label] = history.position()
+	return history
+}
+
+// Delete removes the element at the specified index, records the change and returns the slice.
+// Nothing is recorded if the index is out of bounds.
+func (history *HistorySlice[T]) Delete(i int) *HistorySlice[T] {
+	if history.slice.Bounds(i) {
+		history.record(historyOperation[T]{kind: historyDelete, i: i, values: []T{history.slice[i]}})
+	}
+	return history
+}
+
+// Fetch returns the element at the specified index, or a zero value if the index is out of bounds.
+func (history *HistorySlice[T]) Fetch(i int) T {
+	return history.slice.Fetch(i)
+}
+
+// Get returns the element at the specified index and true, or a zero value and false if the index is out of bounds.
+func (history *HistorySlice[T]) Get(i int) (T, bool) {
+	return history.slice.Get(i)
+}
+
+// Length returns the number of elements in the slice.
+func (history *HistorySlice[T]) Length() int {
+	return history.slice.Length()
+}
+
+// Limit returns the maximum number of operations kept in the undo history, or 0 if the history is unlimited.
+func (history *HistorySlice[T]) Limit() int {
+	return history.limit
+}
+
+// Prepend adds the given values to the beginning of the slice, records the change and returns the slice.
+func (history *HistorySlice[T]) Prepend(values ...T) *HistorySlice[T] {
+	if len(values) > 0 {
+		history.record(historyOperation[T]{kind: historyPrepend, values: slices.Clone(values)})
+	}
+	return history
+}
+
+// Redo reapplies the most recently undone operation and returns true, or false if there is nothing to redo.
+//
+//	newSlice := slice.NewHistory[int](0, 1)
+//	newSlice.Append(2)
+//	newSlice.Undo()
+//	newSlice.Redo()
+//	fmt.Println(newSlice.ToSlice()) // &[1, 2]
+func (history *HistorySlice[T]) Redo() bool {
+	if len(history.redo) == 0 {
+		return false
+	}
+	operation := history.redo[len(history.redo)-1]
+	history.redo = history.redo[:len(history.redo)-1]
+	operation.apply(&history.slice)
+	history.undo = append(history.undo, operation)
+	return true
+}
+
+// RedoLength returns the number of operations that can be redone.
+func (history *HistorySlice[T]) RedoLength() int {
+	return len(history.redo)
+}
+
+// Replace replaces the element at the specified index with the given value, records the change and returns true,
+// or returns false without recording anything if the index is out of bounds.
+func (history *HistorySlice[T]) Replace(i int, value T) bool {
+	if !history.slice.Bounds(i) {
+		return false
+	}
+	history.record(historyOperation[T]{kind: historyReplace, i: i, values: []T{history.slice[i], value}})
+	return true
+}
+
+// Reverse reverses the elements of the slice, records the change and returns the slice.
+func (history *HistorySlice[T]) Reverse() *HistorySlice[T] {
+	history.record(historyOperation[T]{kind: historyReverse})
+	return history
+}
+
+// RevertTo undoes or redoes operations until the history is at the position recorded by the checkpoint with the given label.
+// It returns false without changing the slice if the label is unknown or its operations have been discarded.
+func (history *HistorySlice[T]) RevertTo(label string) bool {
+	target, ok := history.checkpoints[label]
+	if !ok || target < history.discarded || target > history.position()+len(history.redo) {
+		return false
+	}
+	for history.position() > target {
+		history.Undo()
+	}
+	for history.position() < target {
+		history.Redo()
+	}
+	return true
+}
+
+// SortFunc sorts the elements of the slice based on the provided comparison function, records the change and returns the slice.
+// The resulting order is the same as Slice.SortFunc.
+func (history *HistorySlice[T]) SortFunc(fn func(i int, j int, a T, b T) bool) *HistorySlice[T] {
+	history.record(historyOperation[T]{kind: historySort, order: sortOrder(history.slice, fn)})
+	return history
+}
+
+// Splice keeps only the elements from index i to j (inclusive) like Slice.Splice, records the change and returns the slice.
+// Nothing is recorded if either index is out of bounds.
+func (history *HistorySlice[T]) Splice(i int, j int) *HistorySlice[T] {
+	if j < i {
+		i, j = j, i
+	}
+	if history.slice.Bounds(i) && history.slice.Bounds(j) {
+		history.record(historyOperation[T]{
+			kind:   historySplice,
+			i:      i,
+			j:      j,
+			values: slices.Clone(history.slice[:i]),
+			suffix: slices.Clone(history.slice[j+1:]),
+		})
+	}
+	return history
+}
+
+// Swap swaps the elements at the specified indices and records the change.
+// Nothing is recorded if either index is out of bounds.
+func (history *HistorySlice[T]) Swap(i int, j int) {
+	if history.slice.Bounds(i) && history.slice.Bounds(j) {
+		history.record(historyOperation[T]{kind: historySwap, i: i, j: j})
+	}
+}
+
+// ToSlice returns a new slice containing a copy of the elements of the slice.
+func (history *HistorySlice[T]) ToSlice() *Slice[T] {
+	newSlice := make(Slice[T], len(history.slice))
+	copy(newSlice, history.slice)
+	return &newSlice
+}
+
+// Undo reverts the most recent operation and returns true, or false if there is nothing to undo.
+//
+//	newSlice := slice.NewHistory[int](0, 1, 2)
+//	newSlice.Delete(0)
+//	newSlice.Undo()
+//	fmt.Println(newSlice.ToSlice()) // &[1, 2]
+func (history *HistorySlice[T]) Undo() bool {
+	if len(history.undo) == 0 {
+		return false
+	}
+	operation := history.undo[len(history.undo)-1]
+	history.undo = history.undo[:len(history.undo)-1]
+	operation.revert(&history.slice)
+	history.redo = append(history.redo, operation)
+	return true
+}
+
+// UndoLength returns the number of operations that can be undone.
+func (history *HistorySlice[T]) UndoLength() int {
+	return len(history.undo)
+}
+
+// position returns the number of operations applied since the history was created, including discarded ones.
+func (history *HistorySlice[T]) position() int {
+	return history.discarded + len(history.undo)
+}
+
+// record applies the operation, adds it to the undo history and enforces the limit.
+func (history *HistorySlice[T]) record(operation historyOperation[T]) {
+	operation.apply(&history.slice)
+	position := history.position()
+	for label, target := range history.checkpoints {
+		if target > position {
+			delete(history.checkpoints, label) // Checkpoints on the abandoned redo branch.
+		}
+	}
+	clear(history.redo)
+	history.redo = history.redo[:0]
+	history.undo = append(history.undo, operation)
+	if history.limit > 0 && len(history.undo) > history.limit {
+		overflow := len(history.undo) - history.limit
+		history.undo = slices.Delete(history.undo, 0, overflow)
+		history.discarded += overflow
+		for label, target := range history.checkpoints {
+			if target < history.discarded {
+				delete(history.checkpoints, label)
+			}
+		}
+	}
+}
+
+// historyKind identifies the mutation recorded by a historyOperation.
+type historyKind int
+
+const (
+	historyAppend historyKind = iota
+	historyPrepend
+	historyDelete
+	historyReplace
+	historySwap
+	historySplice
+	historyReverse
+	historySort
+)
+
+// historyOperation is a reversible mutation. values holds the values added or removed by the operation
+// (for Replace, the old value followed by the new value; for Splice, the dropped prefix), suffix holds
+// the suffix dropped by Splice and order holds the original index of each element after SortFunc.
+type historyOperation[T any] struct {
+	kind   historyKind
+	i, j   int
+	values []T
+	suffix []T
+	order  []int
+}
+
+// apply performs the operation on the slice.
+func (operation *historyOperation[T]) apply(slice *Slice[T]) {
+	switch operation.kind {
+	case historyAppend:
+		*slice = append(*slice, operation.values...)
+	case historyPrepend:
+		*slice = slices.Insert(*slice, 0, operation.values...)
+	case historyDelete:
+		*slice = slices.Delete(*slice, operation.i, operation.i+1)
+	case historyReplace:
+		(*slice)[operation.i] = operation.values[1]
+	case historySwap:
+		slice.Swap(operation.i, operation.j)
+	case historySplice:
+		*slice = slices.Clone((*slice)[operation.i : operation.j+1])
+	case historyReverse:
+		slices.Reverse(*slice)
+	case historySort:
+		values := slices.Clone(*slice)
+		for i, j := range operation.order {
+			(*slice)[i] = values[j]
+		}
+	}
+}
+
+// revert undoes the operation on the slice.
+func (operation *historyOperation[T]) revert(slice *Slice[T]) {
+	switch operation.kind {
+	case historyAppend:
+		*slice = slices.Delete(*slice, len(*slice)-len(operation.values), len(*slice))
+	case historyPrepend:
+		*slice = slices.Delete(*slice, 0, len(operation.values))
+	case historyDelete:
+		*slice = slices.Insert(*slice, operation.i, operation.values[0])
+	case historyReplace:
+		(*slice)[operation.i] = operation.values[0]
+	case historySwap:
+		slice.Swap(operation.i, operation.j)
+	case historySplice:
+		*slice = slices.Concat(operation.values, *slice, operation.suffix)
+	case historyReverse:
+		slices.Reverse(*slice)
+	case historySort:
+		values := slices.Clone(*slice)
+		for i, j := range operation.order {
+			(*slice)[j] = values[i]
+		}
+	}
+}
+
+// sortOrder returns the original index of each element of values after sorting them with fn.
+// Sorting the indices makes the same comparisons as Slice.SortFunc, so the resulting order is identical.
+func sortOrder[T any](values []T, fn func(i int, j int, a T, b T) bool) []int {
+	order := make([]int, len(values))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i int, j int) bool {
+		return fn(i, j, values[order[i]], values[order[j]])
+	})
+	return order
+}
+
+// NewHistory creates a new instance of the HistorySlice[T] type that keeps at most limit operations
+// in its undo history (or unlimited operations if limit is 0 or less) and initializes it with the provided values.
+// The initial values are not recorded as an operation.
+func NewHistory[T any](limit int, values ...T) *HistorySlice[T] {
+	return &HistorySlice[T]{slice: *New(values...), limit: max(limit, 0)}
+}
@@ -0,0 +1,179 @@
+package slice_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/lindsaygelle/slice"
+)
+
+func TestHistoryUndoRedo(t *testing.T) {
+	s := slice.NewHistory[int](0, 3, 1, 2)
+	states := []*slice.Slice[int]{s.ToSlice()}
+
+	// Apply one of each operation and remember the state after it.
+	s.Append(4, 5)
+	states = append(states, s.ToSlice())
+	s.Prepend(0)
+	states = append(states, s.ToSlice())
+	s.Delete(2)
+	states = append(states, s.ToSlice())
+	s.Replace(0, 10)
+	states = append(states, s.ToSlice())
+	s.Swap(0, 1)
+	states = append(states, s.ToSlice())
+	s.SortFunc(func(i int, j int, a int, b int) bool {
+		return a < b
+	})
+	states = append(states, s.ToSlice())
+	s.Reverse()
+	states = append(states, s.ToSlice())
+	s.Splice(1, 3)
+	states = append(states, s.ToSlice())
+
+	expected := &slice.Slice[int]{5, 4, 3}
+	if result := s.ToSlice(); !reflect.DeepEqual(result, expected) {
+		t.Fatalf("Expected %v, but got %v", expected, result)
+	}
+
+	// Test case 1: Undo every operation in turn.
+	for i := len(states) - 2; i >= 0; i-- {
+		if !s.Undo() {
+			t.Fatalf("Expected Undo to succeed")
+		}
+		if result := s.ToSlice(); !reflect.DeepEqual(result, states[i]) {
+			t.Errorf("Undo to state %d: expected %v, but got %v", i, states[i], result)
+		}
+	}
+	if s.Undo() {
+		t.Errorf("Expected Undo to fail with an empty history")
+	}
+
+	// Test case 2: Redo every operation in turn.
+	for i := 1; i < len(states); i++ {
+		if !s.Redo() {
+			t.Fatalf("Expected Redo to succeed")
+		}
+		if result := s.ToSlice(); !reflect.DeepEqual(result, states[i]) {
+			t.Errorf("Redo to state %d: expected %v, but got %v", i, states[i], result)
+		}
+	}
+	if s.Redo() {
+		t.Errorf("Expected Redo to fail with an empty redo history")
+	}
+}
+
+func TestHistoryInvalidOperations(t *testing.T) {
+	// Test case: Operations that change nothing are not recorded.
+	s := slice.NewHistory[int](0, 1, 2)
+	s.Delete(5)
+	s.Swap(0, 5)
+	s.Splice(0, 5)
+	s.Append()
+	if s.Replace(5, 1) {
+		t.Errorf("Expected false, but got true")
+	}
+	if length := s.UndoLength(); length != 0 {
+		t.Errorf("Expected 0 operations, but got %d", length)
+	}
+}
+
+func TestHistoryRedoCleared(t *testing.T) {
+	// Test case: A new operation clears the redo history.
+	s := slice.NewHistory[int](0, 1)
+	s.Append(2)
+	s.Undo()
+	s.Append(3)
+
+	if s.Redo() {
+		t.Errorf("Expected Redo to fail")
+	}
+	expected := &slice.Slice[int]{1, 3}
+	if result := s.ToSlice(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+}
+
+func TestHistoryCheckpoint(t *testing.T) {
+	s := slice.NewHistory[string](0, "a")
+	s.Checkpoint("start")
+	s.Append("b")
+	s.Checkpoint("middle")
+	s.Append("c").Reverse()
+
+	// Test case 1: Revert backwards to a checkpoint.
+	if !s.RevertTo("start") {
+		t.Fatalf("Expected RevertTo to succeed")
+	}
+	if result := s.ToSlice(); !reflect.DeepEqual(result, &slice.Slice[string]{"a"}) {
+		t.Errorf("Expected %v, but got %v", &slice.Slice[string]{"a"}, result)
+	}
+
+	// Test case 2: Revert forwards through the redo history.
+	if !s.RevertTo("middle") {
+		t.Fatalf("Expected RevertTo to succeed")
+	}
+	if result := s.ToSlice(); !reflect.DeepEqual(result, &slice.Slice[string]{"a", "b"}) {
+		t.Errorf("Expected %v, but got %v", &slice.Slice[string]{"a", "b"}, result)
+	}
+
+	// Test case 3: Checkpoints on an abandoned branch are dropped.
+	s.Checkpoint("end")
+	s.RevertTo("start")
+	s.Prepend("z")
+	if s.RevertTo("middle") || s.RevertTo("end") {
+		t.Errorf("Expected RevertTo to fail for abandoned checkpoints")
+	}
+	if s.RevertTo("unknown") {
+		t.Errorf("Expected RevertTo to fail for an unknown label")
+	}
+}
+
+func TestHistoryLimit(t *testing.T) {
+	// Test case: The oldest operations and their checkpoints are discarded.
+	s := slice.NewHistory[int](2)
+	s.Checkpoint("empty")
+	s.Append(1).Append(2)
+	s.Checkpoint("two")
+	s.Append(3)
+
+	if length := s.UndoLength(); length != 2 {
+		t.Errorf("Expected 2 operations, but got %d", length)
+	}
+	if s.RevertTo("empty") {
+		t.Errorf("Expected RevertTo to fail for a discarded checkpoint")
+	}
+	if !s.RevertTo("two") {
+		t.Errorf("Expected RevertTo to succeed")
+	}
+	s.Undo()
+	if s.Undo() {
+		t.Errorf("Expected Undo to fail past the limit")
+	}
+	expected := &slice.Slice[int]{1}
+	if result := s.ToSlice(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+}
+
+func TestHistorySortFunc(t *testing.T) {
+	// Test case: The order matches Slice.SortFunc, including elements that compare equal.
+	type pair struct{ key, id int }
+	values := make([]pair, 100)
+	for i := range values {
+		values[i] = pair{key: (i * 7) % 5, id: i}
+	}
+	less := func(i int, j int, a pair, b pair) bool {
+		return a.key < b.key
+	}
+	s := slice.NewHistory(0, values...).SortFunc(less)
+	expected := slice.New(values...).SortFunc(less)
+
+	if result := s.ToSlice(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+	s.Undo()
+	if result := s.ToSlice(); !reflect.DeepEqual(result, slice.New(values...)) {
+		t.Errorf("Expected %v, but got %v", values, result)
+	}
+}
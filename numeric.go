@@ -0,0 +1,318 @@
+package slice
+
+import (
+	"math"
+	"slices"
+)
+
+// Integer is a constraint that permits any integer type.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Float is a constraint that permits any floating-point type.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number interface {
+	Integer | Float
+}
+
+// NumericSlice represents a generic slice of numeric data types.
+// It shares its underlying type with Slice[T], so a *Slice[T] can be converted to a *NumericSlice[T] (and back) without copying.
+// Statistics that are not closed over T (such as Mean and Variance) are computed and returned as float64,
+// and return NaN when the slice is empty.
+type NumericSlice[T Number] Slice[T]
+
+// Mean returns the arithmetic mean of the slice, or NaN if the slice is empty.
+// The sum is computed with Neumaier's variant of Kahan summation.
+//
+//	newSlice := slice.NewNumeric[int](1, 2, 3, 4)
+//	mean := newSlice.Mean()
+//	fmt.Println(mean) // 2.5
+func (slice *NumericSlice[T]) Mean() float64 {
+	if len(*slice) == 0 {
+		return math.NaN()
+	}
+	var sum, compensation float64
+	for _, value := range *slice {
+		sum, compensation = neumaierAdd(sum, compensation, float64(value))
+	}
+	return (sum + compensation) / float64(len(*slice))
+}
+
+// Median returns the middle value of the slice, or the mean of the two middle values if the length is even.
+// It returns NaN if the slice is empty. The receiver is not modified.
+//
+//	newSlice := slice.NewNumeric[int](3, 1, 4, 2)
+//	median := newSlice.Median()
+//	fmt.Println(median) // 2.5
+func (slice *NumericSlice[T]) Median() float64 {
+	return slice.Percentile(50)
+}
+
+// Mode returns the most frequent values of the slice in the order they first appear.
+// The returned slice is empty if the receiver is empty.
+//
+//	newSlice := slice.NewNumeric[int](1, 2, 2, 3, 3)
+//	modes := newSlice.Mode()
+//	fmt.Println(modes) // &[2, 3]
+func (slice *NumericSlice[T]) Mode() *Slice[T] {
+	counts := make(map[T]int, len(*slice))
+	var highest int
+	for _, value := range *slice {
+		counts[value]++
+		highest = max(highest, counts[value])
+	}
+	newSlice := &Slice[T]{}
+	for _, value := range *slice {
+		if counts[value] == highest {
+			newSlice.Append(value)
+			counts[value] = 0 // Only report each value once.
+		}
+	}
+	return newSlice
+}
+
+// MovingAverage returns the mean of each window of size consecutive elements, computed in O(n) time.
+// The returned slice has one value per window and is empty if size is less than 1 or greater than the length of the slice.
+// Infinite and NaN values only affect the windows that contain them.
+//
+//	newSlice := slice.NewNumeric[int](1, 2, 3, 4, 5)
+//	averages := newSlice.MovingAverage(2)
+//	fmt.Println(averages) // &[1.5, 2.5, 3.5, 4.5]
+func (slice *NumericSlice[T]) MovingAverage(size int) *Slice[float64] {
+	newSlice := movingSums[T, float64](*slice, size)
+	for i := range newSlice {
+		newSlice[i] /= float64(size)
+	}
+	return &newSlice
+}
+
+// MovingSum returns the sum of each window of size consecutive elements, computed in O(n) time with a running sum.
+// The returned slice has one value per window and is empty if size is less than 1 or greater than the length of the slice.
+// Infinite and NaN values only affect the windows that contain them.
+//
+//	newSlice := slice.NewNumeric[int](1, 2, 3, 4, 5)
+//	sums := newSlice.MovingSum(3)
+//	fmt.Println(sums) // &[6, 9, 12]
+func (slice *NumericSlice[T]) MovingSum(size int) *Slice[T] {
+	newSlice := movingSums[T, T](*slice, size)
+	return &newSlice
+}
+
+// Percentile returns the p-th percentile of the slice using linear interpolation between the closest ranks,
+// or NaN if the slice is empty or p is NaN. p is clamped to the range [0, 100]. The receiver is not modified.
+//
+//	newSlice := slice.NewNumeric[int](1, 2, 3, 4, 5)
+//	percentile := newSlice.Percentile(25)
+//	fmt.Println(percentile) // 2
+func (slice *NumericSlice[T]) Percentile(p float64) float64 {
+	return percentileSorted(slice.sorted(), p)
+}
+
+// Product returns the product of the elements of the slice, or 1 if the slice is empty.
+//
+//	newSlice := slice.NewNumeric[int](1, 2, 3, 4)
+//	product := newSlice.Product()
+//	fmt.Println(product) // 24
+func (slice *NumericSlice[T]) Product() T {
+	var product T = 1
+	for _, value := range *slice {
+		product *= value
+	}
+	return product
+}
+
+// Quantiles divides the sorted slice into n intervals of equal probability and returns the n-1 cut points.
+// The returned slice is empty if n is less than 2 or the receiver is empty. The receiver is not modified.
+//
+//	newSlice := slice.NewNumeric[int](1, 2, 3, 4, 5)
+//	quartiles := newSlice.Quantiles(4)
+//	fmt.Println(quartiles) // &[2, 3, 4]
+func (slice *NumericSlice[T]) Quantiles(n int) *Slice[float64] {
+	newSlice := &Slice[float64]{}
+	if n < 2 || len(*slice) == 0 {
+		return newSlice
+	}
+	sortedSlice := slice.sorted()
+	for i := 1; i < n; i++ {
+		newSlice.Append(percentileSorted(sortedSlice, 100*float64(i)/float64(n)))
+	}
+	return newSlice
+}
+
+// StdDev returns the population standard deviation of the slice, or NaN if the slice is empty.
+//
+//	newSlice := slice.NewNumeric[int](2, 4, 4, 4, 5, 5, 7, 9)
+//	stdDev := newSlice.StdDev()
+//	fmt.Println(stdDev) // 2
+func (slice *NumericSlice[T]) StdDev() float64 {
+	return math.Sqrt(slice.Variance())
+}
+
+// Sum returns the sum of the elements of the slice.
+// Floating-point sums are computed with Neumaier's variant of Kahan summation to reduce rounding error.
+//
+//	newSlice := slice.NewNumeric[float64](0.1, 0.2, 0.3)
+//	sum := newSlice.Sum()
+//	fmt.Println(sum) // 0.6
+func (slice *NumericSlice[T]) Sum() T {
+	var sum, compensation T
+	for _, value := range *slice {
+		sum, compensation = neumaierAdd(sum, compensation, value)
+	}
+	return sum + compensation
+}
+
+// ToSlice returns the slice as a *Slice[T]. The returned slice shares memory with the receiver.
+//
+//	newSlice := slice.NewNumeric[int](1, 2, 3)
+//	fmt.Println(newSlice.ToSlice().Length()) // 3
+func (slice *NumericSlice[T]) ToSlice() *Slice[T] {
+	return (*Slice[T])(slice)
+}
+
+// Variance returns the population variance of the slice, or NaN if the slice is empty.
+// It is computed in a single pass with Welford's algorithm.
+//
+//	newSlice := slice.NewNumeric[int](2, 4, 4, 4, 5, 5, 7, 9)
+//	variance := newSlice.Variance()
+//	fmt.Println(variance) // 4
+func (slice *NumericSlice[T]) Variance() float64 {
+	if len(*slice) == 0 {
+		return math.NaN()
+	}
+	var mean, m2 float64
+	for i, value := range *slice {
+		delta := float64(value) - mean
+		mean += delta / float64(i+1)
+		m2 += delta * (float64(value) - mean)
+	}
+	return m2 / float64(len(*slice))
+}
+
+// sorted returns a sorted copy of the slice.
+func (slice *NumericSlice[T]) sorted() []T {
+	sortedSlice := slices.Clone(*slice)
+	slices.Sort(sortedSlice)
+	return sortedSlice
+}
+
+// AsNumeric returns the given slice as a *NumericSlice[T]. The returned slice shares memory with the argument,
+// so changes made through either value are visible through the other.
+//
+//	newSlice := slice.New[int](1, 2, 3)
+//	sum := slice.AsNumeric(newSlice).Sum()
+//	fmt.Println(sum) // 6
+func AsNumeric[T Number](slice *Slice[T]) *NumericSlice[T] {
+	return (*NumericSlice[T])(slice)
+}
+
+// NewNumeric creates a new instance of the NumericSlice[T] type and initializes it with the provided values.
+func NewNumeric[T Number](values ...T) *NumericSlice[T] {
+	return AsNumeric(New(values...))
+}
+
+// movingSums returns the sum of each window of size consecutive values, converted to S, using a running sum.
+// Infinite and NaN values are counted instead of summed, so the running sum recovers once they leave the window.
+// If the finite values of a window overflow, that window is summed again from scratch.
+func movingSums[T Number, S Number](values []T, size int) Slice[S] {
+	newSlice := Slice[S]{}
+	if size < 1 || size > len(values) {
+		return newSlice
+	}
+	var sum, compensation S
+	var nan, positive, negative int // The number of non-finite values in the window.
+	add := func(value S, delta int) {
+		switch {
+		case value != value:
+			nan += delta
+		case !isFinite(value) && value > 0:
+			positive += delta
+		case !isFinite(value):
+			negative += delta
+		case delta < 0:
+			// Subtracting is exact for integers, including unsigned ones, because overflow wraps.
+			sum, compensation = neumaierAdd(sum, compensation, -value)
+		default:
+			sum, compensation = neumaierAdd(sum, compensation, value)
+		}
+	}
+	for i, value := range values {
+		add(S(value), 1)
+		if i >= size {
+			add(S(values[i-size]), -1)
+		}
+		if i < size-1 {
+			continue
+		}
+		if !isFinite(sum) {
+			sum, compensation = 0, 0
+			for _, value := range values[i-size+1 : i+1] {
+				if value := S(value); isFinite(value) {
+					sum, compensation = neumaierAdd(sum, compensation, value)
+				}
+			}
+		}
+		switch {
+		case nan > 0 || positive > 0 && negative > 0:
+			newSlice = append(newSlice, S(math.NaN()))
+		case positive > 0:
+			newSlice = append(newSlice, S(math.Inf(1)))
+		case negative > 0:
+			newSlice = append(newSlice, S(math.Inf(-1)))
+		default:
+			newSlice = append(newSlice, sum+compensation)
+		}
+	}
+	return newSlice
+}
+
+// neumaierAdd adds value to sum and returns the new sum and the running compensation for lost low-order bits.
+// For integer types the compensation is always zero. Once the sum is infinite or NaN the compensation is
+// no longer updated, because it would become NaN; the sum alone is then the result, as in Python's math.fsum.
+func neumaierAdd[T Number](sum T, compensation T, value T) (T, T) {
+	total := sum + value
+	if !isFinite(total) {
+		return total, compensation
+	}
+	if abs(sum) >= abs(value) {
+		compensation += (sum - total) + value
+	} else {
+		compensation += (value - total) + sum
+	}
+	return total, compensation
+}
+
+// abs returns the absolute value of value.
+func abs[T Number](value T) T {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
+
+// isFinite returns false if value is infinite or NaN. It is always true for integer types.
+func isFinite[T Number](value T) bool {
+	return value-value == 0
+}
+
+// percentileSorted returns the p-th percentile of the sorted values using linear interpolation,
+// or NaN if values is empty or p is NaN.
+func percentileSorted[T Number](values []T, p float64) float64 {
+	if len(values) == 0 || math.IsNaN(p) {
+		return math.NaN()
+	}
+	p = min(max(p, 0), 100)
+	rank := p / 100 * float64(len(values)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	weight := rank - float64(lower)
+	if lower == upper || weight == 0 {
+		return float64(values[lower]) // Interpolating would turn an infinite value into Inf*0, which is NaN.
+	}
+	return float64(values[lower])*(1-weight) + float64(values[upper])*weight
+}
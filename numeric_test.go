@@ -0,0 +1,257 @@
+package slice_test
+
+import (
+	"math"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/lindsaygelle/slice"
+)
+
+func TestNumericMean(t *testing.T) {
+	// Test case 1: Compute the mean of integers without integer division.
+	s := slice.NewNumeric[int](1, 2, 3, 4)
+	if mean := s.Mean(); mean != 2.5 {
+		t.Errorf("Expected 2.5, but got %v", mean)
+	}
+
+	// Test case 2: Compute the mean of an empty slice.
+	s = slice.NewNumeric[int]()
+	if mean := s.Mean(); !math.IsNaN(mean) {
+		t.Errorf("Expected NaN, but got %v", mean)
+	}
+}
+
+func TestNumericMedian(t *testing.T) {
+	// Test case 1: Compute the median of an odd-length slice.
+	s := slice.NewNumeric[int](3, 1, 2)
+	if median := s.Median(); median != 2 {
+		t.Errorf("Expected 2, but got %v", median)
+	}
+
+	// Test case 2: Compute the median of an even-length slice without modifying it.
+	s = slice.NewNumeric[int](4, 1, 3, 2)
+	if median := s.Median(); median != 2.5 {
+		t.Errorf("Expected 2.5, but got %v", median)
+	}
+	expected := slice.NewNumeric[int](4, 1, 3, 2)
+	if !reflect.DeepEqual(s, expected) {
+		t.Errorf("Expected %v, but got %v", expected, s)
+	}
+
+	// Test case 3: An infinite middle value is not interpolated into NaN.
+	if median := slice.NewNumeric(math.Inf(1)).Median(); !math.IsInf(median, 1) {
+		t.Errorf("Expected +Inf, but got %v", median)
+	}
+	if percentile := slice.NewNumeric(1.0, math.Inf(1)).Percentile(100); !math.IsInf(percentile, 1) {
+		t.Errorf("Expected +Inf, but got %v", percentile)
+	}
+	if percentile := slice.NewNumeric(math.Inf(-1), 1.0).Percentile(0); !math.IsInf(percentile, -1) {
+		t.Errorf("Expected -Inf, but got %v", percentile)
+	}
+}
+
+func TestNumericMode(t *testing.T) {
+	// Test case 1: Find a single mode.
+	s := slice.NewNumeric[int](1, 2, 2, 3)
+	result := s.Mode()
+
+	expected := &slice.Slice[int]{2}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+
+	// Test case 2: Find several modes in order of first appearance.
+	s = slice.NewNumeric[int](3, 1, 3, 1, 2)
+	result = s.Mode()
+
+	expected = &slice.Slice[int]{3, 1}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+}
+
+func TestNumericPercentile(t *testing.T) {
+	// Test case 1: Compute percentiles with interpolation.
+	s := slice.NewNumeric[int](5, 1, 4, 2, 3)
+	for p, expected := range map[float64]float64{0: 1, 25: 2, 50: 3, 90: 4.6, 100: 5} {
+		if result := s.Percentile(p); math.Abs(result-expected) > 1e-9 {
+			t.Errorf("Expected %v for p=%v, but got %v", expected, p, result)
+		}
+	}
+
+	// Test case 2: Clamp out-of-range percentiles.
+	if result := s.Percentile(150); result != 5 {
+		t.Errorf("Expected 5, but got %v", result)
+	}
+
+	// Test case 3: A NaN percentile returns NaN instead of panicking.
+	if result := slice.NewNumeric(1.0, 2.0).Percentile(math.NaN()); !math.IsNaN(result) {
+		t.Errorf("Expected NaN, but got %v", result)
+	}
+}
+
+func TestNumericProduct(t *testing.T) {
+	// Test case 1: Compute the product of a slice.
+	s := slice.NewNumeric[int](1, 2, 3, 4)
+	if product := s.Product(); product != 24 {
+		t.Errorf("Expected 24, but got %v", product)
+	}
+
+	// Test case 2: Compute the product of an empty slice.
+	s = slice.NewNumeric[int]()
+	if product := s.Product(); product != 1 {
+		t.Errorf("Expected 1, but got %v", product)
+	}
+}
+
+func TestNumericQuantiles(t *testing.T) {
+	// Test case 1: Compute quartiles.
+	s := slice.NewNumeric[int](1, 2, 3, 4, 5)
+	result := s.Quantiles(4)
+
+	expected := &slice.Slice[float64]{2, 3, 4}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+
+	// Test case 2: Request fewer than two intervals.
+	result = s.Quantiles(1)
+	if result.Length() != 0 {
+		t.Errorf("Expected length 0, but got %d", result.Length())
+	}
+}
+
+func TestNumericSum(t *testing.T) {
+	// Test case 1: Sum integers.
+	s := slice.NewNumeric[int](1, 2, 3, 4)
+	if sum := s.Sum(); sum != 10 {
+		t.Errorf("Expected 10, but got %v", sum)
+	}
+
+	// Test case 2: Sum floats that lose precision with naive summation.
+	f := slice.NewNumeric[float64](1e100, 1.0, -1e100)
+	if sum := f.Sum(); sum != 1 {
+		t.Errorf("Expected 1, but got %v", sum)
+	}
+
+	// Test case 3: Infinite values and overflowing sums are infinite, not NaN.
+	testCases := []struct {
+		values   *slice.NumericSlice[float64]
+		expected float64
+	}{
+		{slice.NewNumeric(math.Inf(1), 1.0), math.Inf(1)},
+		{slice.NewNumeric(1.0, math.Inf(-1), 2.0), math.Inf(-1)},
+		{slice.NewNumeric(1e308, 1e308, -1e308), math.Inf(1)},
+		{slice.NewNumeric(-1e308, -1e308), math.Inf(-1)},
+	}
+	for i, test := range testCases {
+		if sum := test.values.Sum(); sum != test.expected {
+			t.Errorf("Test case 3.%d: Expected %v, but got %v", i+1, test.expected, sum)
+		}
+		if mean := test.values.Mean(); mean != test.expected {
+			t.Errorf("Test case 3.%d: Expected a mean of %v, but got %v", i+1, test.expected, mean)
+		}
+	}
+
+	// Test case 4: Opposite infinities sum to NaN.
+	if sum := slice.NewNumeric(math.Inf(1), math.Inf(-1)).Sum(); !math.IsNaN(sum) {
+		t.Errorf("Expected NaN, but got %v", sum)
+	}
+}
+
+func TestNumericVariance(t *testing.T) {
+	// Test case 1: Compute the population variance and standard deviation.
+	s := slice.NewNumeric[int](2, 4, 4, 4, 5, 5, 7, 9)
+	if variance := s.Variance(); variance != 4 {
+		t.Errorf("Expected 4, but got %v", variance)
+	}
+	if stdDev := s.StdDev(); stdDev != 2 {
+		t.Errorf("Expected 2, but got %v", stdDev)
+	}
+
+	// Test case 2: Compute the variance of values with a large offset.
+	f := slice.NewNumeric[float64](1e9+4, 1e9+7, 1e9+13, 1e9+16)
+	if variance := f.Variance(); variance != 22.5 {
+		t.Errorf("Expected 22.5, but got %v", variance)
+	}
+}
+
+func TestAsNumeric(t *testing.T) {
+	// Test case: Compute statistics over an existing slice.
+	s := slice.New[int](1, 2, 3)
+	if sum := slice.AsNumeric(s).Sum(); sum != 6 {
+		t.Errorf("Expected 6, but got %v", sum)
+	}
+	if result := slice.AsNumeric(s).ToSlice(); result != s {
+		t.Errorf("Expected result to be the same slice, but got a different slice")
+	}
+}
+
+func TestNumericMovingSum(t *testing.T) {
+	// Test case 1: Integers.
+	result := slice.NewNumeric[int](1, 2, 3, 4, 5).MovingSum(3)
+	expected := &slice.Slice[int]{6, 9, 12}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+
+	// Test case 2: Unsigned integers wrap when the oldest value is subtracted.
+	unsigned := slice.NewNumeric[uint8](200, 50, 5, 250).MovingSum(2)
+	if expected := (&slice.Slice[uint8]{250, 55, 255}); !reflect.DeepEqual(unsigned, expected) {
+		t.Errorf("Expected %v, but got %v", expected, unsigned)
+	}
+
+	// Test case 3: Floats keep their precision over many windows.
+	values := make([]float64, 10000)
+	for i := range values {
+		values[i] = 0.1
+	}
+	values[0] = 1e16
+	sums := slice.NewNumeric(values...).MovingSum(10)
+	if last := sums.Fetch(sums.Length() - 1); math.Abs(last-1) > 1e-9 {
+		t.Errorf("Expected 1, but got %v", last)
+	}
+
+	// Test case 4: Invalid sizes.
+	if result := slice.NewNumeric[int](1, 2).MovingSum(3); result.Length() != 0 {
+		t.Errorf("Expected an empty slice, but got %v", result)
+	}
+
+	// Test case 5: Infinite and NaN values only affect the windows that contain them.
+	inf := math.Inf(1)
+	floats := slice.NewNumeric(inf, 1.0, 2.0, -inf, 3.0, math.NaN(), 4.0, 5.0).MovingSum(2)
+	if expected := []float64{inf, 3, -inf, -inf, math.NaN(), math.NaN(), 9}; !slices.EqualFunc(*floats, expected, sameFloat) {
+		t.Errorf("Expected %v, but got %v", expected, floats)
+	}
+	if result := slice.NewNumeric(inf, 1.0, -inf, 2.0).MovingSum(3); !slices.EqualFunc(*result, []float64{math.NaN(), -inf}, sameFloat) {
+		t.Errorf("Expected NaN, then -Inf, but got %v", result)
+	}
+
+	// Test case 6: Windows whose finite values overflow recover once the overflow leaves the window.
+	overflow := slice.NewNumeric(1e308, 1e308, 1.0, 2.0).MovingSum(2)
+	if expected := []float64{inf, 1e308, 3}; !slices.EqualFunc(*overflow, expected, sameFloat) {
+		t.Errorf("Expected %v, but got %v", expected, overflow)
+	}
+}
+
+func TestNumericMovingAverage(t *testing.T) {
+	result := slice.NewNumeric[int](1, 2, 3, 4, 5).MovingAverage(2)
+	expected := &slice.Slice[float64]{1.5, 2.5, 3.5, 4.5}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+	if result := slice.NewNumeric[int](1).MovingAverage(0); result.Length() != 0 {
+		t.Errorf("Expected an empty slice, but got %v", result)
+	}
+	inf := math.Inf(1)
+	if result := slice.NewNumeric(inf, 1.0, 3.0).MovingAverage(2); !slices.EqualFunc(*result, []float64{inf, 2}, sameFloat) {
+		t.Errorf("Expected %v, but got %v", []float64{inf, 2}, result)
+	}
+}
+
+// sameFloat reports whether a and b are equal, treating NaN as equal to NaN.
+func sameFloat(a float64, b float64) bool {
+	return a == b || math.IsNaN(a) && math.IsNaN(b)
+}
@@ -0,0 +1,335 @@
+package slice
+
+import (
+	"slices"
+)
+
+// ChangeEvent is implemented by the events delivered to the subscribers of an ObservableSlice:
+// InsertEvent, RemoveEvent, UpdateEvent and ReorderEvent.
+type ChangeEvent[T any] interface {
+	changeEvent()
+}
+
+// InsertEvent reports that Values were inserted so that the first of them is at Index.
+type InsertEvent[T any] struct {
+	Index  int
+	Values []T
+}
+
+// RemoveEvent reports that Values were removed starting at Index.
+type RemoveEvent[T any] struct {
+	Index  int
+	Values []T
+}
+
+// UpdateEvent reports that the element at Index changed from Old to New.
+type UpdateEvent[T any] struct {
+	Index int
+	Old   T
+	New   T
+}
+
+// ReorderEvent reports that the elements were permuted without being added or removed.
+// The element now at index i was previously at index Order[i].
+type ReorderEvent[T any] struct {
+	Order []int
+}
+
+func (InsertEvent[T]) changeEvent()  {}
+func (RemoveEvent[T]) changeEvent()  {}
+func (UpdateEvent[T]) changeEvent()  {}
+func (ReorderEvent[T]) changeEvent() {}
+
+// ObservableSlice represents a generic slice that notifies subscribers when it changes.
+//
+// Delivery contract:
+//   - Events are delivered synchronously, after the change has been applied, in the order the changes happened.
+//     Applying the events in order to a copy of the previous values reproduces the current values.
+//   - Outside Batch, each method call delivers its events as one call to each subscriber.
+//     Inside Batch, the events of every change are delivered together in one call once the outermost Batch returns.
+//   - Subscribers are called in the order they subscribed. A subscriber added during delivery only receives later events,
+//     and a subscriber that is unsubscribed during delivery receives no further events.
+//   - A subscriber may change the slice; the resulting events are delivered after every subscriber has received the current ones.
+//   - Event values are copies; subscribers may keep them but must not modify them.
+//
+// An ObservableSlice is not safe for concurrent use. The zero value is an empty slice ready to use.
+type ObservableSlice[T any] struct {
+	slice       Slice[T]
+	subscribers []*observableSubscriber[T]
+	batchDepth  int
+	pending     []ChangeEvent[T]
+	delivering  bool
+	queue       []observableDelivery[T]
+}
+
+// Append adds the given values to the end of the slice, delivers an InsertEvent and returns the slice.
+//
+//	newSlice := slice.NewObservable[int](1)
+//	newSlice.Subscribe(func(events []slice.ChangeEvent[int]) {
+//	    fmt.Println(events)
+//	})
+//	newSlice.Append(2, 3) // [{1 [2 3]}]
+func (observable *ObservableSlice[T]) Append(values ...T) *ObservableSlice[T] {
+	if len(values) > 0 {
+		index := len(observable.slice)
+		observable.slice.Append(values...)
+		observable.emit(InsertEvent[T]{Index: index, Values: slices.Clone(values)})
+	}
+	return observable
+}
+
+// Batch calls fn and delivers the events of every change it makes together once it returns, even if fn panics.
+// Nested calls are merged into the outermost Batch.
+//
+//	newSlice := slice.NewObservable[int]()
+//	newSlice.Subscribe(func(events []slice.ChangeEvent[int]) {
+//	    fmt.Println(len(events))
+//	})
+//	newSlice.Batch(func(s *slice.ObservableSlice[int]) {
+//	    s.Append(1)
+//	    s.Append(2)
+//	}) // 2
+func (observable *ObservableSlice[T]) Batch(fn func(observable *ObservableSlice[T])) *ObservableSlice[T] {
+	observable.batchDepth++
+	defer func() {
+		observable.batchDepth--
+		if observable.batchDepth == 0 {
+			events := observable.pending
+			observable.pending = nil
+			observable.deliver(events)
+		}
+	}()
+	fn(observable)
+	return observable
+}
+
+// Delete removes the element at the specified index, delivers a RemoveEvent and returns the slice.
+// Nothing happens if the index is out of bounds.
+func (observable *ObservableSlice[T]) Delete(i int) *ObservableSlice[T] {
+	if observable.slice.Bounds(i) {
+		value := observable.slice[i]
+		observable.slice.DeleteUnsafe(i)
+		observable.emit(RemoveEvent[T]{Index: i, Values: []T{value}})
+	}
+	return observable
+}
+
+// Each applies the given function to each element of the slice and returns the slice.
+func (observable *ObservableSlice[T]) Each(fn func(i int, value T)) *ObservableSlice[T] {
+	observable.slice.Each(fn)
+	return observable
+}
+
+// Fetch returns the element at the specified index, or a zero value if the index is out of bounds.
+func (observable *ObservableSlice[T]) Fetch(i int) T {
+	return observable.slice.Fetch(i)
+}
+
+// Get returns the element at the specified index and true, or a zero value and false if the index is out of bounds.
+func (observable *ObservableSlice[T]) Get(i int) (T, bool) {
+	return observable.slice.Get(i)
+}
+
+// Length returns the number of elements in the slice.
+func (observable *ObservableSlice[T]) Length() int {
+	return observable.slice.Length()
+}
+
+// Poll removes and returns the first element of the slice and delivers a RemoveEvent, or returns a zero value if the slice is empty.
+func (observable *ObservableSlice[T]) Poll() T {
+	value, _ := observable.PollOK()
+	return value
+}
+
+// PollOK removes and returns the first element of the slice and true and delivers a RemoveEvent,
+// or returns a zero value and false if the slice is empty.
+func (observable *ObservableSlice[T]) PollOK() (T, bool) {
+	value, ok := observable.slice.PollOK()
+	if ok {
+		observable.emit(RemoveEvent[T]{Index: 0, Values: []T{value}})
+	}
+	return value, ok
+}
+
+// Pop removes and returns the last element of the slice and delivers a RemoveEvent, or returns a zero value if the slice is empty.
+func (observable *ObservableSlice[T]) Pop() T {
+	value, _ := observable.PopOK()
+	return value
+}
+
+// PopOK removes and returns the last element of the slice and true and delivers a RemoveEvent,
+// or returns a zero value and false if the slice is empty.
+func (observable *ObservableSlice[T]) PopOK() (T, bool) {
+	value, ok := observable.slice.PopOK()
+	if ok {
+		observable.emit(RemoveEvent[T]{Index: len(observable.slice), Values: []T{value}})
+	}
+	return value, ok
+}
+
+// Prepend adds the given values to the beginning of the slice, delivers an InsertEvent and returns the slice.
+func (observable *ObservableSlice[T]) Prepend(values ...T) *ObservableSlice[T] {
+	if len(values) > 0 {
+		observable.slice = slices.Insert(observable.slice, 0, values...)
+		observable.emit(InsertEvent[T]{Index: 0, Values: slices.Clone(values)})
+	}
+	return observable
+}
+
+// Replace replaces the element at the specified index with the given value, delivers an UpdateEvent and returns true,
+// or returns false if the index is out of bounds.
+func (observable *ObservableSlice[T]) Replace(i int, value T) bool {
+	if !observable.slice.Bounds(i) {
+		return false
+	}
+	oldValue := observable.slice[i]
+	observable.slice[i] = value
+	observable.emit(UpdateEvent[T]{Index: i, Old: oldValue, New: value})
+	return true
+}
+
+// Reverse reverses the elements of the slice, delivers a ReorderEvent and returns the slice.
+// Nothing is delivered if the slice has fewer than two elements.
+func (observable *ObservableSlice[T]) Reverse() *ObservableSlice[T] {
+	if len(observable.slice) > 1 {
+		order := make([]int, len(observable.slice))
+		for i := range order {
+			order[i] = len(order) - 1 - i
+		}
+		slices.Reverse(observable.slice)
+		observable.emit(ReorderEvent[T]{Order: order})
+	}
+	return observable
+}
+
+// SortFunc sorts the elements of the slice like Slice.SortFunc, delivers a ReorderEvent and returns the slice.
+// Nothing is delivered if the slice has fewer than two elements.
+func (observable *ObservableSlice[T]) SortFunc(fn func(i int, j int, a T, b T) bool) *ObservableSlice[T] {
+	if len(observable.slice) > 1 {
+		order := sortOrder(observable.slice, fn)
+		values := slices.Clone(observable.slice)
+		for i, j := range order {
+			observable.slice[i] = values[j]
+		}
+		observable.emit(ReorderEvent[T]{Order: order})
+	}
+	return observable
+}
+
+// Splice keeps only the elements from index i to j (inclusive) like Slice.Splice and returns the slice.
+// It delivers a RemoveEvent for the dropped suffix followed by a RemoveEvent for the dropped prefix.
+// Nothing happens if either index is out of bounds.
+func (observable *ObservableSlice[T]) Splice(i int, j int) *ObservableSlice[T] {
+	if j < i {
+		i, j = j, i
+	}
+	if !observable.slice.Bounds(i) || !observable.slice.Bounds(j) {
+		return observable
+	}
+	var events []ChangeEvent[T]
+	if j+1 < len(observable.slice) {
+		events = append(events, RemoveEvent[T]{Index: j + 1, Values: slices.Clone(observable.slice[j+1:])})
+	}
+	if i > 0 {
+		events = append(events, RemoveEvent[T]{Index: 0, Values: slices.Clone(observable.slice[:i])})
+	}
+	observable.slice.Splice(i, j)
+	observable.emit(events...)
+	return observable
+}
+
+// Subscribe registers a function that receives the events of every later change and returns a function that unsubscribes it.
+// Calling the returned function more than once has no effect.
+//
+//	newSlice := slice.NewObservable[int]()
+//	unsubscribe := newSlice.Subscribe(func(events []slice.ChangeEvent[int]) {
+//	    fmt.Println(events)
+//	})
+//	newSlice.Append(1) // [{0 [1]}]
+//	unsubscribe()
+//	newSlice.Append(2)
+func (observable *ObservableSlice[T]) Subscribe(fn func(events []ChangeEvent[T])) func() {
+	subscriber := &observableSubscriber[T]{fn: fn, active: true}
+	observable.subscribers = append(slices.Clip(observable.subscribers), subscriber)
+	return func() {
+		if !subscriber.active {
+			return
+		}
+		subscriber.active = false
+		// Replace rather than modify the list, so queued deliveries keep their own copy.
+		observable.subscribers = slices.DeleteFunc(slices.Clone(observable.subscribers), func(other *observableSubscriber[T]) bool {
+			return other == subscriber
+		})
+	}
+}
+
+// Swap swaps the elements at the specified indices and delivers an UpdateEvent for each index.
+// Nothing happens if either index is out of bounds or the indices are equal.
+func (observable *ObservableSlice[T]) Swap(i int, j int) {
+	if i == j || !observable.slice.Bounds(i) || !observable.slice.Bounds(j) {
+		return
+	}
+	a, b := observable.slice[i], observable.slice[j]
+	observable.slice.Swap(i, j)
+	observable.emit(UpdateEvent[T]{Index: i, Old: a, New: b}, UpdateEvent[T]{Index: j, Old: b, New: a})
+}
+
+// ToSlice returns a new slice containing a copy of the elements of the slice.
+func (observable *ObservableSlice[T]) ToSlice() *Slice[T] {
+	newSlice := make(Slice[T], len(observable.slice))
+	copy(newSlice, observable.slice)
+	return &newSlice
+}
+
+// deliver passes the events to every subscriber that is active when they are delivered,
+// queueing them if a delivery is already in progress.
+func (observable *ObservableSlice[T]) deliver(events []ChangeEvent[T]) {
+	if len(events) == 0 || len(observable.subscribers) == 0 {
+		return
+	}
+	// Capture the subscribers now, so one added later does not receive events that happened before it subscribed.
+	observable.queue = append(observable.queue, observableDelivery[T]{events: events, subscribers: observable.subscribers})
+	if observable.delivering {
+		return
+	}
+	observable.delivering = true
+	defer func() {
+		observable.delivering = false
+		observable.queue = nil
+	}()
+	for len(observable.queue) > 0 {
+		delivery := observable.queue[0]
+		observable.queue = observable.queue[1:]
+		for _, subscriber := range delivery.subscribers {
+			if subscriber.active {
+				subscriber.fn(delivery.events)
+			}
+		}
+	}
+}
+
+// emit delivers the events of one change, or holds them until the outermost Batch returns.
+func (observable *ObservableSlice[T]) emit(events ...ChangeEvent[T]) {
+	if observable.batchDepth > 0 {
+		observable.pending = append(observable.pending, events...)
+		return
+	}
+	observable.deliver(events)
+}
+
+// observableSubscriber is a function registered with Subscribe.
+type observableSubscriber[T any] struct {
+	fn     func(events []ChangeEvent[T])
+	active bool
+}
+
+// observableDelivery is a set of events waiting to be delivered and the subscribers that should receive them.
+type observableDelivery[T any] struct {
+	events      []ChangeEvent[T]
+	subscribers []*observableSubscriber[T]
+}
+
+// NewObservable creates a new instance of the ObservableSlice[T] type and initializes it with the provided values.
+func NewObservable[T any](values ...T) *ObservableSlice[T] {
+	return &ObservableSlice[T]{slice: *New(values...)}
+}
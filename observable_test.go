@@ -0,0 +1,167 @@
+package slice_test
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/lindsaygelle/slice"
+)
+
+// replay applies the events to values and returns the result.
+func replay[T any](values []T, events []slice.ChangeEvent[T]) []T {
+	values = slices.Clone(values)
+	for _, event := range events {
+		switch event := event.(type) {
+		case slice.InsertEvent[T]:
+			values = slices.Insert(values, event.Index, event.Values...)
+		case slice.RemoveEvent[T]:
+			values = slices.Delete(values, event.Index, event.Index+len(event.Values))
+		case slice.UpdateEvent[T]:
+			values[event.Index] = event.New
+		case slice.ReorderEvent[T]:
+			previous := slices.Clone(values)
+			for i, j := range event.Order {
+				values[i] = previous[j]
+			}
+		}
+	}
+	return values
+}
+
+func TestObservableEvents(t *testing.T) {
+	// Test case: Replaying the delivered events reproduces the slice after every change.
+	s := slice.NewObservable[int](5, 3, 1)
+	mirror := []int{5, 3, 1}
+	s.Subscribe(func(events []slice.ChangeEvent[int]) {
+		mirror = replay(mirror, events)
+	})
+
+	check := func(step string) {
+		t.Helper()
+		if result := s.ToSlice(); !reflect.DeepEqual([]int(*result), mirror) {
+			t.Errorf("%s: expected %v, but got %v", step, result, mirror)
+		}
+	}
+	s.Append(4, 2)
+	check("Append")
+	s.Prepend(0)
+	check("Prepend")
+	s.Delete(2)
+	check("Delete")
+	s.Replace(1, 9)
+	check("Replace")
+	s.Swap(0, 3)
+	check("Swap")
+	s.Poll()
+	check("Poll")
+	s.Pop()
+	check("Pop")
+	s.Append(7, 8, 6)
+	s.SortFunc(func(i int, j int, a int, b int) bool {
+		return a < b
+	})
+	check("SortFunc")
+	s.Reverse()
+	check("Reverse")
+	s.Splice(1, 3)
+	check("Splice")
+}
+
+func TestObservableEventValues(t *testing.T) {
+	s := slice.NewObservable[string]("a", "b")
+	var received []slice.ChangeEvent[string]
+	s.Subscribe(func(events []slice.ChangeEvent[string]) {
+		received = append(received, events...)
+	})
+	s.Replace(1, "c")
+	s.Delete(0)
+
+	expected := []slice.ChangeEvent[string]{
+		slice.UpdateEvent[string]{Index: 1, Old: "b", New: "c"},
+		slice.RemoveEvent[string]{Index: 0, Values: []string{"a"}},
+	}
+	if !reflect.DeepEqual(received, expected) {
+		t.Errorf("Expected %v, but got %v", expected, received)
+	}
+}
+
+func TestObservableBatch(t *testing.T) {
+	s := slice.NewObservable[int]()
+	var deliveries [][]slice.ChangeEvent[int]
+	s.Subscribe(func(events []slice.ChangeEvent[int]) {
+		deliveries = append(deliveries, events)
+	})
+
+	// Test case 1: Nested batches deliver once, in order, after the outermost batch returns.
+	s.Batch(func(s *slice.ObservableSlice[int]) {
+		s.Append(1)
+		s.Batch(func(s *slice.ObservableSlice[int]) {
+			s.Append(2)
+		})
+		if len(deliveries) != 0 {
+			t.Errorf("Expected no deliveries inside the batch, but got %d", len(deliveries))
+		}
+		s.Delete(0)
+	})
+
+	expected := [][]slice.ChangeEvent[int]{{
+		slice.InsertEvent[int]{Index: 0, Values: []int{1}},
+		slice.InsertEvent[int]{Index: 1, Values: []int{2}},
+		slice.RemoveEvent[int]{Index: 0, Values: []int{1}},
+	}}
+	if !reflect.DeepEqual(deliveries, expected) {
+		t.Errorf("Expected %v, but got %v", expected, deliveries)
+	}
+
+	// Test case 2: A batch without changes delivers nothing.
+	s.Batch(func(s *slice.ObservableSlice[int]) {})
+	if len(deliveries) != 1 {
+		t.Errorf("Expected 1 delivery, but got %d", len(deliveries))
+	}
+}
+
+func TestObservableSubscribe(t *testing.T) {
+	s := slice.NewObservable[int]()
+	var first, second int
+	unsubscribeFirst := s.Subscribe(func(events []slice.ChangeEvent[int]) {
+		first++
+	})
+	var unsubscribeSecond func()
+	unsubscribeSecond = s.Subscribe(func(events []slice.ChangeEvent[int]) {
+		second++
+		unsubscribeSecond() // Unsubscribing during delivery stops later deliveries.
+	})
+
+	s.Append(1)
+	s.Append(2)
+	unsubscribeFirst()
+	unsubscribeFirst()
+	s.Append(3)
+
+	if first != 2 || second != 1 {
+		t.Errorf("Expected 2 and 1 deliveries, but got %d and %d", first, second)
+	}
+}
+
+func TestObservableReentrant(t *testing.T) {
+	// Test case: Changes made by a subscriber are delivered after every subscriber has received the current events.
+	s := slice.NewObservable[int]()
+	var log []string
+	s.Subscribe(func(events []slice.ChangeEvent[int]) {
+		event := events[0].(slice.InsertEvent[int])
+		log = append(log, "first")
+		if event.Values[0] == 1 {
+			s.Append(2)
+		}
+	})
+	s.Subscribe(func(events []slice.ChangeEvent[int]) {
+		log = append(log, "second")
+	})
+	s.Append(1)
+
+	expected := []string{"first", "second", "first", "second"}
+	if !reflect.DeepEqual(log, expected) {
+		t.Errorf("Expected %v, but got %v", expected, log)
+	}
+}
@@ -0,0 +1,142 @@
+package slice
+
+import (
+	"cmp"
+	"slices"
+)
+
+// OrderedSlice represents a generic slice of ordered data types.
+// It shares its underlying type with Slice[T], so a *Slice[T] can be converted to an *OrderedSlice[T] (and back) without copying.
+type OrderedSlice[T cmp.Ordered] Slice[T]
+
+// BinarySearch searches for the given value in the slice and returns the index where it is found and true,
+// or the index where it would be inserted and false. The slice must be sorted in ascending order.
+//
+//	newSlice := slice.NewOrdered[int](1, 3, 5, 7)
+//	index, found := newSlice.BinarySearch(5)
+//	fmt.Println(index, found) // 2, true
+func (slice *OrderedSlice[T]) BinarySearch(value T) (int, bool) {
+	return slices.BinarySearch(*slice, value)
+}
+
+// Clamp limits each element of the slice to the range [low, high] and returns the modified slice.
+// If low is greater than high the arguments are swapped.
+//
+//	newSlice := slice.NewOrdered[int](-5, 3, 12)
+//	newSlice.Clamp(0, 10)
+//	fmt.Println(newSlice) // &[0, 3, 10]
+func (slice *OrderedSlice[T]) Clamp(low T, high T) *OrderedSlice[T] {
+	if high < low {
+		low, high = high, low
+	}
+	for i, value := range *slice {
+		(*slice)[i] = min(max(value, low), high)
+	}
+	return slice
+}
+
+// Insert adds the given values to the slice at the positions that keep it sorted in ascending order and returns the modified slice.
+// The slice must already be sorted in ascending order.
+//
+//	newSlice := slice.NewOrdered[int](1, 3, 5)
+//	newSlice.Insert(4, 0)
+//	fmt.Println(newSlice) // &[0, 1, 3, 4, 5]
+func (slice *OrderedSlice[T]) Insert(values ...T) *OrderedSlice[T] {
+	for _, value := range values {
+		i, _ := slices.BinarySearch(*slice, value)
+		*slice = slices.Insert(*slice, i, value)
+	}
+	return slice
+}
+
+// IsSorted checks if the slice is sorted in ascending order.
+//
+//	newSlice := slice.NewOrdered[int](1, 2, 3)
+//	isSorted := newSlice.IsSorted()
+//	fmt.Println(isSorted) // true
+func (slice *OrderedSlice[T]) IsSorted() bool {
+	return slices.IsSorted(*slice)
+}
+
+// Max returns the largest element of the slice and true, or a zero value and false if the slice is empty.
+//
+//	newSlice := slice.NewOrdered[int](3, 1, 2)
+//	value, ok := newSlice.Max()
+//	fmt.Println(value, ok) // 3, true
+func (slice *OrderedSlice[T]) Max() (T, bool) {
+	_, value, ok := slice.MinMax()
+	return value, ok
+}
+
+// Min returns the smallest element of the slice and true, or a zero value and false if the slice is empty.
+//
+//	newSlice := slice.NewOrdered[int](3, 1, 2)
+//	value, ok := newSlice.Min()
+//	fmt.Println(value, ok) // 1, true
+func (slice *OrderedSlice[T]) Min() (T, bool) {
+	value, _, ok := slice.MinMax()
+	return value, ok
+}
+
+// MinMax returns the smallest and largest elements of the slice and true, or zero values and false if the slice is empty.
+//
+//	newSlice := slice.NewOrdered[int](3, 1, 2)
+//	low, high, ok := newSlice.MinMax()
+//	fmt.Println(low, high, ok) // 1, 3, true
+func (slice *OrderedSlice[T]) MinMax() (T, T, bool) {
+	var low, high T
+	if len(*slice) == 0 {
+		return low, high, false
+	}
+	low, high = (*slice)[0], (*slice)[0]
+	for _, value := range (*slice)[1:] {
+		low = min(low, value)
+		high = max(high, value)
+	}
+	return low, high, true
+}
+
+// Sort sorts the elements of the slice in ascending order and returns the modified slice.
+//
+//	newSlice := slice.NewOrdered[int](5, 2, 1, 4, 3)
+//	newSlice.Sort()
+//	fmt.Println(newSlice) // &[1, 2, 3, 4, 5]
+func (slice *OrderedSlice[T]) Sort() *OrderedSlice[T] {
+	slices.Sort(*slice)
+	return slice
+}
+
+// SortDescending sorts the elements of the slice in descending order and returns the modified slice.
+//
+//	newSlice := slice.NewOrdered[int](5, 2, 1, 4, 3)
+//	newSlice.SortDescending()
+//	fmt.Println(newSlice) // &[5, 4, 3, 2, 1]
+func (slice *OrderedSlice[T]) SortDescending() *OrderedSlice[T] {
+	slices.SortFunc(*slice, func(a T, b T) int {
+		return cmp.Compare(b, a)
+	})
+	return slice
+}
+
+// ToSlice returns the slice as a *Slice[T]. The returned slice shares memory with the receiver.
+//
+//	newSlice := slice.NewOrdered[int](3, 1, 2)
+//	fmt.Println(newSlice.Sort().ToSlice()) // &[1, 2, 3]
+func (slice *OrderedSlice[T]) ToSlice() *Slice[T] {
+	return (*Slice[T])(slice)
+}
+
+// AsOrdered returns the given slice as an *OrderedSlice[T]. The returned slice shares memory with the argument,
+// so changes made through either value are visible through the other.
+//
+//	newSlice := slice.New[int](3, 1, 2)
+//	slice.AsOrdered(newSlice).Sort()
+//	fmt.Println(newSlice) // &[1, 2, 3]
+func AsOrdered[T cmp.Ordered](slice *Slice[T]) *OrderedSlice[T] {
+	return (*OrderedSlice[T])(slice)
+}
+
+// NewOrdered creates a new instance of the OrderedSlice[T] type and initializes it with the provided values.
+func NewOrdered[T cmp.Ordered](values ...T) *OrderedSlice[T] {
+	return AsOrdered(New(values...))
+}
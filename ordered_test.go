@@ -0,0 +1,130 @@
+package slice_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/lindsaygelle/slice"
+)
+
+func TestOrderedBinarySearch(t *testing.T) {
+	// Test case 1: Search for a value that exists in the slice.
+	s := slice.NewOrdered[int](1, 3, 5, 7)
+	index, ok := s.BinarySearch(5)
+	if !ok || index != 2 {
+		t.Errorf("Expected 2 and true, but got %d and %v", index, ok)
+	}
+
+	// Test case 2: Search for a value that does not exist in the slice.
+	index, ok = s.BinarySearch(4)
+	if ok || index != 2 {
+		t.Errorf("Expected 2 and false, but got %d and %v", index, ok)
+	}
+}
+
+func TestOrderedClamp(t *testing.T) {
+	// Test case 1: Clamp values to a range.
+	s := slice.NewOrdered[int](-5, 3, 12)
+	s.Clamp(0, 10)
+
+	expected := slice.NewOrdered[int](0, 3, 10)
+	if !reflect.DeepEqual(s, expected) {
+		t.Errorf("Expected %v, but got %v", expected, s)
+	}
+
+	// Test case 2: Clamp values with the bounds given in reverse order.
+	s = slice.NewOrdered[int](-5, 3, 12)
+	s.Clamp(10, 0)
+	if !reflect.DeepEqual(s, expected) {
+		t.Errorf("Expected %v, but got %v", expected, s)
+	}
+}
+
+func TestOrderedInsert(t *testing.T) {
+	// Test case 1: Insert values into a sorted slice.
+	s := slice.NewOrdered[int](1, 3, 5)
+	s.Insert(4, 0, 6, 3)
+
+	expected := slice.NewOrdered[int](0, 1, 3, 3, 4, 5, 6)
+	if !reflect.DeepEqual(s, expected) {
+		t.Errorf("Expected %v, but got %v", expected, s)
+	}
+
+	// Test case 2: Insert values into an empty slice.
+	s = slice.NewOrdered[int]()
+	s.Insert(2, 1)
+
+	expected = slice.NewOrdered[int](1, 2)
+	if !reflect.DeepEqual(s, expected) {
+		t.Errorf("Expected %v, but got %v", expected, s)
+	}
+}
+
+func TestOrderedIsSorted(t *testing.T) {
+	// Test case 1: Check a sorted slice.
+	s := slice.NewOrdered[string]("a", "b", "c")
+	if !s.IsSorted() {
+		t.Errorf("Expected true, but got false")
+	}
+
+	// Test case 2: Check an unsorted slice.
+	s = slice.NewOrdered[string]("b", "a", "c")
+	if s.IsSorted() {
+		t.Errorf("Expected false, but got true")
+	}
+}
+
+func TestOrderedMinMax(t *testing.T) {
+	// Test case 1: Find the smallest and largest values.
+	s := slice.NewOrdered[int](3, 1, 4, 1, 5)
+	low, high, ok := s.MinMax()
+	if !ok || low != 1 || high != 5 {
+		t.Errorf("Expected 1, 5 and true, but got %d, %d and %v", low, high, ok)
+	}
+	if value, ok := s.Min(); !ok || value != 1 {
+		t.Errorf("Expected 1 and true, but got %d and %v", value, ok)
+	}
+	if value, ok := s.Max(); !ok || value != 5 {
+		t.Errorf("Expected 5 and true, but got %d and %v", value, ok)
+	}
+
+	// Test case 2: Find values in an empty slice.
+	s = slice.NewOrdered[int]()
+	if _, _, ok := s.MinMax(); ok {
+		t.Errorf("Expected false, but got true")
+	}
+}
+
+func TestOrderedSort(t *testing.T) {
+	// Test case 1: Sort in ascending order.
+	s := slice.NewOrdered[int](5, 2, 1, 4, 3)
+	s.Sort()
+
+	expected := slice.NewOrdered[int](1, 2, 3, 4, 5)
+	if !reflect.DeepEqual(s, expected) {
+		t.Errorf("Expected %v, but got %v", expected, s)
+	}
+
+	// Test case 2: Sort in descending order.
+	s.SortDescending()
+
+	expected = slice.NewOrdered[int](5, 4, 3, 2, 1)
+	if !reflect.DeepEqual(s, expected) {
+		t.Errorf("Expected %v, but got %v", expected, s)
+	}
+}
+
+func TestAsOrdered(t *testing.T) {
+	// Test case: Changes made through the ordered view are visible in the original slice.
+	s := slice.New[int](3, 1, 2)
+	slice.AsOrdered(s).Sort().Insert(0)
+	s.Append(4)
+
+	expected := &slice.Slice[int]{0, 1, 2, 3, 4}
+	if !reflect.DeepEqual(s, expected) {
+		t.Errorf("Expected %v, but got %v", expected, s)
+	}
+	if !slice.AsOrdered(s).IsSorted() {
+		t.Errorf("Expected true, but got false")
+	}
+}
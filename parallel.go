@@ -0,0 +1,178 @@
+package slice
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// ParallelOptions configures the worker pool used by the Parallel methods of Slice.
+// The zero value is ready to use.
+type ParallelOptions struct {
+	// Context cancels the remaining work when it is done. Defaults to context.Background().
+	Context context.Context
+	// Workers is the maximum number of goroutines used. Defaults to runtime.GOMAXPROCS(0).
+	Workers int
+	// ChunkSize is the number of consecutive elements handed to a worker at a time.
+	// Defaults to a size that gives each worker about four chunks.
+	ChunkSize int
+}
+
+// ParallelEach applies the given function to each element of the slice using a bounded pool of goroutines.
+// The function may be called for different elements concurrently and in any order.
+// It returns the context error if the options context is done before every element has been visited.
+//
+//	newSlice := &slice.Slice[string]{"a.png", "b.png"}
+//	err := newSlice.ParallelEach(slice.ParallelOptions{Workers: 2}, func(i int, value string) {
+//	    makeThumbnail(value)
+//	})
+//	fmt.Println(err) // <nil>
+func (slice *Slice[T]) ParallelEach(options ParallelOptions, fn func(i int, value T)) error {
+	return parallelChunks(slice.Length(), options, func(_ int, start int, end int) {
+		for i := start; i < end; i++ {
+			fn(i, (*slice)[i])
+		}
+	})
+}
+
+// ParallelFilter returns a new slice containing the elements that satisfy the provided function, in their original order.
+// The function is evaluated using a bounded pool of goroutines.
+// It returns nil and the context error if the options context is done before every element has been visited.
+//
+//	newSlice := &slice.Slice[int]{1, 2, 3, 4, 5}
+//	filteredSlice, err := newSlice.ParallelFilter(slice.ParallelOptions{}, func(i int, value int) bool {
+//	    return value%2 == 0
+//	})
+//	fmt.Println(filteredSlice, err) // &[2, 4], <nil>
+func (slice *Slice[T]) ParallelFilter(options ParallelOptions, fn func(i int, value T) bool) (*Slice[T], error) {
+	keep := make([]bool, slice.Length())
+	err := parallelChunks(slice.Length(), options, func(_ int, start int, end int) {
+		for i := start; i < end; i++ {
+			keep[i] = fn(i, (*slice)[i])
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	newSlice := &Slice[T]{}
+	for i, ok := range keep {
+		if ok {
+			newSlice.Append((*slice)[i])
+		}
+	}
+	return newSlice, nil
+}
+
+// ParallelMap applies the given function to each element of the slice using a bounded pool of goroutines
+// and returns a new slice with the modified elements in their original order.
+// It returns nil and the context error if the options context is done before every element has been visited.
+//
+//	newSlice := &slice.Slice[int]{1, 2, 3}
+//	mappedSlice, err := newSlice.ParallelMap(slice.ParallelOptions{}, func(i int, value int) int {
+//	    return value * 2
+//	})
+//	fmt.Println(mappedSlice, err) // &[2, 4, 6], <nil>
+func (slice *Slice[T]) ParallelMap(options ParallelOptions, fn func(i int, value T) T) (*Slice[T], error) {
+	newSlice := make(Slice[T], slice.Length())
+	err := parallelChunks(slice.Length(), options, func(_ int, start int, end int) {
+		for i := start; i < end; i++ {
+			newSlice[i] = fn(i, (*slice)[i])
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &newSlice, nil
+}
+
+// ParallelReduce reduces each chunk of the slice with the given function using a bounded pool of goroutines,
+// then merges the chunk results from left to right with the combine function.
+// Each chunk starts from the zero value of T, as Reduce does, so the result only matches Reduce when the
+// zero value is an identity for combine and the reduction is associative (such as a sum or a maximum).
+// It returns a zero value and the context error if the options context is done before every element has been visited.
+//
+//	newSlice := &slice.Slice[int]{1, 2, 3, 4, 5}
+//	result, err := newSlice.ParallelReduce(slice.ParallelOptions{}, func(i int, currentValue int, resultValue int) int {
+//	    return resultValue + currentValue
+//	}, func(a int, b int) int {
+//	    return a + b
+//	})
+//	fmt.Println(result, err) // 15, <nil>
+func (slice *Slice[T]) ParallelReduce(options ParallelOptions, fn func(i int, currentValue T, resultValue T) T, combine func(a T, b T) T) (T, error) {
+	var resultValue T
+	chunkSize := options.chunkSize(slice.Length())
+	chunkValues := make([]T, (slice.Length()+chunkSize-1)/chunkSize)
+	err := parallelChunks(slice.Length(), options, func(chunk int, start int, end int) {
+		var chunkValue T
+		for i := start; i < end; i++ {
+			chunkValue = fn(i, (*slice)[i], chunkValue)
+		}
+		chunkValues[chunk] = chunkValue
+	})
+	if err != nil {
+		return resultValue, err
+	}
+	for i, chunkValue := range chunkValues {
+		if i == 0 {
+			resultValue = chunkValue
+		} else {
+			resultValue = combine(resultValue, chunkValue)
+		}
+	}
+	return resultValue, nil
+}
+
+// chunkSize returns the chunk size to use for a slice of the given length.
+func (options ParallelOptions) chunkSize(length int) int {
+	if options.ChunkSize > 0 {
+		return options.ChunkSize
+	}
+	chunks := options.workers() * 4
+	return max((length+chunks-1)/chunks, 1)
+}
+
+// workers returns the number of goroutines to use.
+func (options ParallelOptions) workers() int {
+	if options.Workers > 0 {
+		return options.Workers
+	}
+	return runtime.GOMAXPROCS(0)
+}
+
+// parallelChunks splits [0, length) into consecutive chunks and calls fn for each chunk from a bounded pool of goroutines.
+// Workers stop picking up new chunks once the options context is done, in which case the context error is returned.
+func parallelChunks(length int, options ParallelOptions, fn func(chunk int, start int, end int)) error {
+	ctx := options.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if err := ctx.Err(); err != nil || length == 0 {
+		return err
+	}
+	chunkSize := options.chunkSize(length)
+	chunks := (length + chunkSize - 1) / chunkSize
+	workers := min(options.workers(), chunks)
+
+	var next atomic.Int64
+	var waitGroup sync.WaitGroup
+	waitGroup.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer waitGroup.Done()
+			for ctx.Err() == nil {
+				chunk := int(next.Add(1) - 1)
+				if chunk >= chunks {
+					return
+				}
+				start := chunk * chunkSize
+				fn(chunk, start, min(start+chunkSize, length))
+			}
+		}()
+	}
+	waitGroup.Wait()
+	if int(next.Load()) < chunks {
+		return ctx.Err()
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package slice_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync/atomic"
+	"testing"
+
+	"github.com/lindsaygelle/slice"
+)
+
+func TestParallelEach(t *testing.T) {
+	// Test case 1: Visit every element exactly once.
+	s := &slice.Slice[int]{}
+	for i := 0; i < 1000; i++ {
+		s.Append(i)
+	}
+	visits := make([]int32, s.Length())
+	var sum atomic.Int64
+	err := s.ParallelEach(slice.ParallelOptions{Workers: 4, ChunkSize: 7}, func(i int, value int) {
+		atomic.AddInt32(&visits[i], 1)
+		sum.Add(int64(value))
+	})
+	if err != nil {
+		t.Errorf("Expected no error, but got %v", err)
+	}
+	for i, count := range visits {
+		if count != 1 {
+			t.Errorf("Expected index %d to be visited once, but got %d", i, count)
+		}
+	}
+	if sum.Load() != 499500 {
+		t.Errorf("Expected sum 499500, but got %d", sum.Load())
+	}
+
+	// Test case 2: Stop when the context is cancelled.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = s.ParallelEach(slice.ParallelOptions{Context: ctx}, func(i int, value int) {})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected %v, but got %v", context.Canceled, err)
+	}
+}
+
+func TestParallelFilter(t *testing.T) {
+	// Test case 1: Match the sequential Filter method.
+	s := &slice.Slice[int]{}
+	for i := 0; i < 1000; i++ {
+		s.Append(i)
+	}
+	fn := func(i int, value int) bool {
+		return value%3 == 0
+	}
+	result, err := s.ParallelFilter(slice.ParallelOptions{Workers: 8, ChunkSize: 10}, fn)
+
+	expected := s.Filter(fn)
+	if err != nil || !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v and no error, but got %v and %v", expected, result, err)
+	}
+
+	// Test case 2: Filter an empty slice.
+	s = &slice.Slice[int]{}
+	result, err = s.ParallelFilter(slice.ParallelOptions{}, fn)
+	if err != nil || result.Length() != 0 {
+		t.Errorf("Expected an empty slice and no error, but got %v and %v", result, err)
+	}
+}
+
+func TestParallelMap(t *testing.T) {
+	// Test case 1: Match the sequential Map method.
+	s := &slice.Slice[int]{}
+	for i := 0; i < 1000; i++ {
+		s.Append(i)
+	}
+	fn := func(i int, value int) int {
+		return value*value + i
+	}
+	result, err := s.ParallelMap(slice.ParallelOptions{Workers: 3}, fn)
+
+	expected := s.Map(fn)
+	if err != nil || !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v and no error, but got %v and %v", expected, result, err)
+	}
+
+	// Test case 2: Stop when the context is cancelled part way through.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	result, err = s.ParallelMap(slice.ParallelOptions{Context: ctx, Workers: 1, ChunkSize: 1}, func(i int, value int) int {
+		if i == 10 {
+			cancel()
+		}
+		return value
+	})
+	if !errors.Is(err, context.Canceled) || result != nil {
+		t.Errorf("Expected nil and %v, but got %v and %v", context.Canceled, result, err)
+	}
+}
+
+func TestParallelReduce(t *testing.T) {
+	// Test case 1: Match the sequential Reduce method for an associative reduction.
+	s := &slice.Slice[int]{}
+	for i := 0; i < 1000; i++ {
+		s.Append(i)
+	}
+	fn := func(i int, currentValue int, resultValue int) int {
+		return resultValue + currentValue
+	}
+	result, err := s.ParallelReduce(slice.ParallelOptions{Workers: 4, ChunkSize: 33}, fn, func(a int, b int) int {
+		return a + b
+	})
+
+	expected := s.Reduce(fn)
+	if err != nil || result != expected {
+		t.Errorf("Expected %v and no error, but got %v and %v", expected, result, err)
+	}
+
+	// Test case 2: Keep the chunk results in order when combining.
+	w := &slice.Slice[string]{"a", "b", "c", "d", "e"}
+	concatenated, err := w.ParallelReduce(slice.ParallelOptions{Workers: 5, ChunkSize: 1}, func(i int, currentValue string, resultValue string) string {
+		return resultValue + currentValue
+	}, func(a string, b string) string {
+		return a + b
+	})
+	if err != nil || concatenated != "abcde" {
+		t.Errorf("Expected %v and no error, but got %v and %v", "abcde", concatenated, err)
+	}
+}
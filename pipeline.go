@@ -0,0 +1,300 @@
+package slice
+
+import (
+	"iter"
+	"reflect"
+)
+
+// Pipeline represents a lazy sequence of stages applied to the values of a source iterator.
+// Stages such as Filter, Map and Take only describe the work; nothing runs until a terminal
+// operation such as Collect, Count, First, ForEach or Reduce is called. All stages are then
+// fused into a single pass over the source without building intermediate slices.
+// A pipeline can be run more than once if its source can be iterated more than once.
+type Pipeline[T any] struct {
+	seq iter.Seq[T]
+}
+
+// Collect runs the pipeline and returns a new slice containing the values it produces.
+//
+//	newSlice := &slice.Slice[int]{1, 2, 3, 4, 5}
+//	result := newSlice.Pipeline().Filter(func(value int) bool {
+//	    return value%2 == 1
+//	}).Collect()
+//	fmt.Println(result) // &[1, 3, 5]
+func (pipeline *Pipeline[T]) Collect() *Slice[T] {
+	return FromSeq(pipeline.seq)
+}
+
+// Count runs the pipeline and returns the number of values it produces.
+//
+//	newSlice := &slice.Slice[int]{1, 2, 3, 4, 5}
+//	count := newSlice.Pipeline().Skip(2).Count()
+//	fmt.Println(count) // 3
+func (pipeline *Pipeline[T]) Count() int {
+	var count int
+	for range pipeline.seq {
+		count++
+	}
+	return count
+}
+
+// Distinct adds a stage that drops values equal to a value already produced by the pipeline.
+// Comparable values are tracked in a map; other values are compared with reflect.DeepEqual.
+//
+//	newSlice := &slice.Slice[int]{1, 2, 1, 3, 2}
+//	result := newSlice.Pipeline().Distinct().Collect()
+//	fmt.Println(result) // &[1, 2, 3]
+func (pipeline *Pipeline[T]) Distinct() *Pipeline[T] {
+	seq := pipeline.seq
+	return NewPipeline(func(yield func(T) bool) {
+		seenValues := make(map[any]struct{})
+		var seenOthers []T
+		for value := range seq {
+			if reflect.ValueOf(&value).Elem().Comparable() {
+				if _, ok := seenValues[value]; ok {
+					continue
+				}
+				seenValues[value] = struct{}{}
+			} else {
+				if containsDeepEqual(seenOthers, value) {
+					continue
+				}
+				seenOthers = append(seenOthers, value)
+			}
+			if !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+// DropWhile adds a stage that skips values while the provided function returns true and produces every value after that.
+//
+//	newSlice := &slice.Slice[int]{1, 2, 3, 1}
+//	result := newSlice.Pipeline().DropWhile(func(value int) bool {
+//	    return value < 3
+//	}).Collect()
+//	fmt.Println(result) // &[3, 1]
+func (pipeline *Pipeline[T]) DropWhile(fn func(value T) bool) *Pipeline[T] {
+	seq := pipeline.seq
+	return NewPipeline(func(yield func(T) bool) {
+		dropping := true
+		for value := range seq {
+			if dropping && fn(value) {
+				continue
+			}
+			dropping = false
+			if !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+// Filter adds a stage that only produces values that satisfy the provided function.
+//
+//	newSlice := &slice.Slice[int]{1, 2, 3, 4}
+//	result := newSlice.Pipeline().Filter(func(value int) bool {
+//	    return value%2 == 0
+//	}).Collect()
+//	fmt.Println(result) // &[2, 4]
+func (pipeline *Pipeline[T]) Filter(fn func(value T) bool) *Pipeline[T] {
+	seq := pipeline.seq
+	return NewPipeline(func(yield func(T) bool) {
+		for value := range seq {
+			if fn(value) && !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+// First runs the pipeline until it produces a value and returns that value and true,
+// or a zero value and false if the pipeline produces no values.
+//
+//	newSlice := &slice.Slice[int]{1, 2, 3, 4}
+//	value, ok := newSlice.Pipeline().Filter(func(value int) bool {
+//	    return value > 2
+//	}).First()
+//	fmt.Println(value, ok) // 3, true
+func (pipeline *Pipeline[T]) First() (T, bool) {
+	for value := range pipeline.seq {
+		return value, true
+	}
+	var value T
+	return value, false
+}
+
+// FlatMap adds a stage that replaces each value with the values yielded by the iterator returned from the provided function.
+//
+//	newSlice := &slice.Slice[int]{1, 2}
+//	result := newSlice.Pipeline().FlatMap(func(value int) iter.Seq[int] {
+//	    return slices.Values([]int{value, value * 10})
+//	}).Collect()
+//	fmt.Println(result) // &[1, 10, 2, 20]
+func (pipeline *Pipeline[T]) FlatMap(fn func(value T) iter.Seq[T]) *Pipeline[T] {
+	seq := pipeline.seq
+	return NewPipeline(func(yield func(T) bool) {
+		for value := range seq {
+			for innerValue := range fn(value) {
+				if !yield(innerValue) {
+					return
+				}
+			}
+		}
+	})
+}
+
+// ForEach runs the pipeline and applies the given function to each value it produces.
+//
+//	newSlice := &slice.Slice[int]{1, 2, 3}
+//	newSlice.Pipeline().ForEach(func(value int) {
+//	    fmt.Println(value)
+//	})
+//	// Output:
+//	// 1
+//	// 2
+//	// 3
+func (pipeline *Pipeline[T]) ForEach(fn func(value T)) {
+	for value := range pipeline.seq {
+		fn(value)
+	}
+}
+
+// Map adds a stage that replaces each value with the result of the provided function.
+//
+//	newSlice := &slice.Slice[int]{1, 2, 3}
+//	result := newSlice.Pipeline().Map(func(value int) int {
+//	    return value * 2
+//	}).Collect()
+//	fmt.Println(result) // &[2, 4, 6]
+func (pipeline *Pipeline[T]) Map(fn func(value T) T) *Pipeline[T] {
+	seq := pipeline.seq
+	return NewPipeline(func(yield func(T) bool) {
+		for value := range seq {
+			if !yield(fn(value)) {
+				return
+			}
+		}
+	})
+}
+
+// Peek adds a stage that calls the provided function with each value as it passes through the pipeline.
+//
+//	newSlice := &slice.Slice[int]{1, 2, 3}
+//	newSlice.Pipeline().Peek(func(value int) {
+//	    fmt.Println(value)
+//	}).First()
+//	// Output:
+//	// 1
+func (pipeline *Pipeline[T]) Peek(fn func(value T)) *Pipeline[T] {
+	seq := pipeline.seq
+	return NewPipeline(func(yield func(T) bool) {
+		for value := range seq {
+			fn(value)
+			if !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+// Reduce runs the pipeline, applies the given function to each value it produces and returns the reduced result value.
+//
+//	newSlice := &slice.Slice[int]{1, 2, 3, 4, 5}
+//	result := newSlice.Pipeline().Reduce(func(currentValue int, resultValue int) int {
+//	    return resultValue + currentValue
+//	})
+//	fmt.Println(result) // 15
+func (pipeline *Pipeline[T]) Reduce(fn func(currentValue T, resultValue T) T) T {
+	var resultValue T
+	for currentValue := range pipeline.seq {
+		resultValue = fn(currentValue, resultValue)
+	}
+	return resultValue
+}
+
+// Skip adds a stage that drops the first n values.
+//
+//	newSlice := &slice.Slice[int]{1, 2, 3, 4}
+//	result := newSlice.Pipeline().Skip(2).Collect()
+//	fmt.Println(result) // &[3, 4]
+func (pipeline *Pipeline[T]) Skip(n int) *Pipeline[T] {
+	seq := pipeline.seq
+	return NewPipeline(func(yield func(T) bool) {
+		var skipped int
+		for value := range seq {
+			if skipped < n {
+				skipped++
+				continue
+			}
+			if !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+// Take adds a stage that produces at most the first n values and then stops pulling from the source.
+//
+//	newSlice := &slice.Slice[int]{1, 2, 3, 4}
+//	result := newSlice.Pipeline().Take(2).Collect()
+//	fmt.Println(result) // &[1, 2]
+func (pipeline *Pipeline[T]) Take(n int) *Pipeline[T] {
+	seq := pipeline.seq
+	return NewPipeline(func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		var taken int
+		for value := range seq {
+			taken++
+			if !yield(value) || taken == n {
+				return
+			}
+		}
+	})
+}
+
+// TakeWhile adds a stage that produces values while the provided function returns true and stops at the first value that fails it.
+//
+//	newSlice := &slice.Slice[int]{1, 2, 3, 1}
+//	result := newSlice.Pipeline().TakeWhile(func(value int) bool {
+//	    return value < 3
+//	}).Collect()
+//	fmt.Println(result) // &[1, 2]
+func (pipeline *Pipeline[T]) TakeWhile(fn func(value T) bool) *Pipeline[T] {
+	seq := pipeline.seq
+	return NewPipeline(func(yield func(T) bool) {
+		for value := range seq {
+			if !fn(value) || !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+// Values returns an iterator over the values produced by the pipeline.
+//
+//	newSlice := &slice.Slice[int]{1, 2, 3}
+//	for value := range newSlice.Pipeline().Take(2).Values() {
+//	    fmt.Println(value)
+//	}
+func (pipeline *Pipeline[T]) Values() iter.Seq[T] {
+	return pipeline.seq
+}
+
+// NewPipeline creates a new instance of the Pipeline[T] type that reads its values from the given iterator.
+func NewPipeline[T any](seq iter.Seq[T]) *Pipeline[T] {
+	return &Pipeline[T]{seq: seq}
+}
+
+// containsDeepEqual checks if values contains a value that is deeply equal to the given value.
+func containsDeepEqual[T any](values []T, value T) bool {
+	for _, v := range values {
+		if reflect.DeepEqual(v, value) {
+			return true
+		}
+	}
+	return false
+}
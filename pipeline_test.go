@@ -0,0 +1,180 @@
+package slice_test
+
+import (
+	"iter"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/lindsaygelle/slice"
+)
+
+func TestPipelineCollect(t *testing.T) {
+	// Test case 1: Collect a pipeline with several fused stages.
+	s := &slice.Slice[int]{1, 2, 3, 4, 5, 6}
+	result := s.Pipeline().Filter(func(value int) bool {
+		return value%2 == 0
+	}).Map(func(value int) int {
+		return value * 10
+	}).Collect()
+
+	expected := &slice.Slice[int]{20, 40, 60}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+
+	// Test case 2: Stages run lazily in a single pass.
+	var visited []int
+	p := s.Pipeline().Peek(func(value int) {
+		visited = append(visited, value)
+	}).Filter(func(value int) bool {
+		return value > 1
+	}).Take(2)
+	if len(visited) != 0 {
+		t.Errorf("Expected no values to be visited, but got %v", visited)
+	}
+	result = p.Collect()
+
+	expected = &slice.Slice[int]{2, 3}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+	if !reflect.DeepEqual(visited, []int{1, 2, 3}) {
+		t.Errorf("Expected %v, but got %v", []int{1, 2, 3}, visited)
+	}
+
+	// Test case 3: Run the same pipeline again.
+	if result = p.Collect(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+}
+
+func TestPipelineCount(t *testing.T) {
+	// Test case: Count the values produced by a pipeline.
+	s := &slice.Slice[int]{1, 2, 3, 4, 5}
+	if count := s.Pipeline().Skip(2).Count(); count != 3 {
+		t.Errorf("Expected count 3, but got %d", count)
+	}
+}
+
+func TestPipelineDistinct(t *testing.T) {
+	// Test case 1: Drop repeated comparable values.
+	s := &slice.Slice[int]{1, 2, 1, 3, 2}
+	result := s.Pipeline().Distinct().Collect()
+
+	expected := &slice.Slice[int]{1, 2, 3}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+
+	// Test case 2: Drop repeated values that are not comparable.
+	n := &slice.Slice[[]int]{{1}, {2}, {1}}
+	nested := n.Pipeline().Distinct().Collect()
+
+	expectedNested := &slice.Slice[[]int]{{1}, {2}}
+	if !reflect.DeepEqual(nested, expectedNested) {
+		t.Errorf("Expected %v, but got %v", expectedNested, nested)
+	}
+}
+
+func TestPipelineDropWhile(t *testing.T) {
+	// Test case: Drop values until the function fails.
+	s := &slice.Slice[int]{1, 2, 3, 1}
+	result := s.Pipeline().DropWhile(func(value int) bool {
+		return value < 3
+	}).Collect()
+
+	expected := &slice.Slice[int]{3, 1}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+}
+
+func TestPipelineFirst(t *testing.T) {
+	// Test case 1: Find the first matching value.
+	s := &slice.Slice[int]{1, 2, 3, 4}
+	value, ok := s.Pipeline().Filter(func(value int) bool {
+		return value > 2
+	}).First()
+	if !ok || value != 3 {
+		t.Errorf("Expected 3 and true, but got %d and %v", value, ok)
+	}
+
+	// Test case 2: Find no value.
+	_, ok = s.Pipeline().Skip(10).First()
+	if ok {
+		t.Errorf("Expected false, but got true")
+	}
+}
+
+func TestPipelineFlatMap(t *testing.T) {
+	// Test case: Expand each value into several values.
+	s := &slice.Slice[int]{1, 2, 3}
+	result := s.Pipeline().FlatMap(func(value int) iter.Seq[int] {
+		return slices.Values([]int{value, value * 10})
+	}).Take(3).Collect()
+
+	expected := &slice.Slice[int]{1, 10, 2}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+}
+
+func TestPipelineForEach(t *testing.T) {
+	// Test case: Apply a function to each value.
+	s := &slice.Slice[int]{1, 2, 3}
+	var result []int
+	s.Pipeline().ForEach(func(value int) {
+		result = append(result, value)
+	})
+	if !reflect.DeepEqual(result, []int{1, 2, 3}) {
+		t.Errorf("Expected %v, but got %v", []int{1, 2, 3}, result)
+	}
+}
+
+func TestPipelineReduce(t *testing.T) {
+	// Test case: Reduce the values of a pipeline.
+	s := &slice.Slice[int]{1, 2, 3, 4, 5}
+	result := s.Pipeline().Filter(func(value int) bool {
+		return value%2 == 1
+	}).Reduce(func(currentValue int, resultValue int) int {
+		return resultValue + currentValue
+	})
+	if result != 9 {
+		t.Errorf("Expected 9, but got %d", result)
+	}
+}
+
+func TestPipelineTake(t *testing.T) {
+	// Test case 1: Take no values without pulling from the source.
+	var pulled bool
+	p := slice.NewPipeline(func(yield func(int) bool) {
+		pulled = true
+		yield(1)
+	})
+	if count := p.Take(0).Count(); count != 0 || pulled {
+		t.Errorf("Expected count 0 without pulling, but got %d and %v", count, pulled)
+	}
+
+	// Test case 2: Take more values than the source provides.
+	s := &slice.Slice[int]{1, 2}
+	result := s.Pipeline().Take(5).Collect()
+
+	expected := &slice.Slice[int]{1, 2}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+}
+
+func TestPipelineTakeWhile(t *testing.T) {
+	// Test case: Take values while the function holds.
+	s := &slice.Slice[int]{1, 2, 3, 1}
+	result := s.Pipeline().TakeWhile(func(value int) bool {
+		return value < 3
+	}).Collect()
+
+	expected := &slice.Slice[int]{1, 2}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+}
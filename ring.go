@@ -0,0 +1,151 @@
+package slice
+
+// RingSlice represents a generic slice with a fixed capacity. Once it is full, appending a value
+// overwrites the oldest value, which makes it suitable for rolling logs and metrics.
+// Indices are relative to the oldest value, so index 0 is always the oldest value held.
+//
+// Use NewRing to create a RingSlice with a capacity; the zero value has a capacity of 0 and holds nothing.
+type RingSlice[T any] struct {
+	buffer []T
+	head   int
+	length int
+}
+
+// Append appends the given values to the RingSlice, overwriting the oldest values once it is full, and returns the RingSlice.
+//
+//	ring := slice.NewRing[int](3)
+//	ring.Append(1, 2, 3, 4)
+//	fmt.Println(ring.ToSlice()) // &[2, 3, 4]
+func (ring *RingSlice[T]) Append(values ...T) *RingSlice[T] {
+	capacity := len(ring.buffer)
+	if capacity == 0 {
+		return ring
+	}
+	if len(values) > capacity {
+		values = values[len(values)-capacity:] // Only the newest values survive.
+	}
+	for _, value := range values {
+		if ring.length < capacity {
+			ring.buffer[ring.index(ring.length)] = value
+			ring.length++
+		} else {
+			ring.buffer[ring.head] = value
+			ring.head = ring.index(1)
+		}
+	}
+	return ring
+}
+
+// Capacity returns the maximum number of values the RingSlice holds.
+func (ring *RingSlice[T]) Capacity() int {
+	return len(ring.buffer)
+}
+
+// Drain removes every value from the RingSlice and returns them, oldest first, in a new slice.
+//
+//	ring := slice.NewRing[int](3, 1, 2, 3, 4)
+//	values := ring.Drain()
+//	fmt.Println(values, ring.Length()) // &[2, 3, 4], 0
+func (ring *RingSlice[T]) Drain() *Slice[T] {
+	newSlice := ring.ToSlice()
+	clear(ring.buffer)
+	ring.head = 0
+	ring.length = 0
+	return newSlice
+}
+
+// Each applies the given function to each value of the RingSlice from oldest to newest and returns the RingSlice.
+//
+//	ring := slice.NewRing[int](2, 1, 2, 3)
+//	ring.Each(func(i int, value int) {
+//	    fmt.Println(i, value)
+//	})
+//	// Output:
+//	// 0 2
+//	// 1 3
+func (ring *RingSlice[T]) Each(fn func(i int, value T)) *RingSlice[T] {
+	for i := 0; i < ring.length; i++ {
+		fn(i, ring.buffer[ring.index(i)])
+	}
+	return ring
+}
+
+// EachReverse applies the given function to each value of the RingSlice from newest to oldest and returns the RingSlice.
+//
+//	ring := slice.NewRing[int](2, 1, 2, 3)
+//	ring.EachReverse(func(i int, value int) {
+//	    fmt.Println(i, value)
+//	})
+//	// Output:
+//	// 1 3
+//	// 0 2
+func (ring *RingSlice[T]) EachReverse(fn func(i int, value T)) *RingSlice[T] {
+	for i := ring.length - 1; i >= 0; i-- {
+		fn(i, ring.buffer[ring.index(i)])
+	}
+	return ring
+}
+
+// Fetch returns the value at the specified index relative to the oldest value, or a zero value if the index is out of bounds.
+//
+//	ring := slice.NewRing[int](3, 1, 2, 3, 4)
+//	value := ring.Fetch(0)
+//	fmt.Println(value) // 2
+func (ring *RingSlice[T]) Fetch(i int) T {
+	value, _ := ring.Get(i)
+	return value
+}
+
+// Full returns true if the RingSlice holds as many values as its capacity, or false otherwise.
+//
+//	ring := slice.NewRing[int](2, 1, 2)
+//	fmt.Println(ring.Full()) // true
+func (ring *RingSlice[T]) Full() bool {
+	return ring.length == len(ring.buffer)
+}
+
+// Get returns the value at the specified index relative to the oldest value and true, or a zero value and false if the index is out of bounds.
+//
+//	ring := slice.NewRing[int](3, 1, 2, 3, 4)
+//	value, ok := ring.Get(2)
+//	fmt.Println(value, ok) // 4, true
+func (ring *RingSlice[T]) Get(i int) (T, bool) {
+	var value T
+	if i < 0 || i >= ring.length {
+		return value, false
+	}
+	return ring.buffer[ring.index(i)], true
+}
+
+// IsEmpty returns true if the RingSlice is empty, or false otherwise.
+func (ring *RingSlice[T]) IsEmpty() bool {
+	return ring.length == 0
+}
+
+// Length returns the number of values in the RingSlice.
+func (ring *RingSlice[T]) Length() int {
+	return ring.length
+}
+
+// ToSlice returns a new slice containing the values of the RingSlice, oldest first.
+//
+//	ring := slice.NewRing[int](3, 1, 2, 3, 4)
+//	fmt.Println(ring.ToSlice()) // &[2, 3, 4]
+func (ring *RingSlice[T]) ToSlice() *Slice[T] {
+	newSlice := make(Slice[T], ring.length)
+	n := copy(newSlice, ring.buffer[ring.head:min(ring.head+ring.length, len(ring.buffer))])
+	copy(newSlice[n:], ring.buffer[:ring.length-n])
+	return &newSlice
+}
+
+// index returns the buffer index of the value at logical index i.
+func (ring *RingSlice[T]) index(i int) int {
+	return (ring.head + i) % len(ring.buffer)
+}
+
+// NewRing creates a new instance of the RingSlice[T] type with the given capacity and initializes it with the provided values.
+// If more values than the capacity are provided, only the newest values are kept.
+func NewRing[T any](capacity int, values ...T) *RingSlice[T] {
+	ring := &RingSlice[T]{buffer: make([]T, max(capacity, 0))}
+	return ring.Append(values...)
+}
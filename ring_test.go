@@ -0,0 +1,110 @@
+package slice_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/lindsaygelle/slice"
+)
+
+func TestRingAppend(t *testing.T) {
+	// Test case 1: Append values without filling the ring.
+	r := slice.NewRing[int](3)
+	r.Append(1, 2)
+
+	expected := &slice.Slice[int]{1, 2}
+	if result := r.ToSlice(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+	if r.Full() {
+		t.Errorf("Expected false, but got true")
+	}
+
+	// Test case 2: Overwrite the oldest values once full.
+	r.Append(3, 4, 5)
+
+	expected = &slice.Slice[int]{3, 4, 5}
+	if result := r.ToSlice(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+	if !r.Full() {
+		t.Errorf("Expected true, but got false")
+	}
+
+	// Test case 3: Append more values than the capacity at once.
+	r.Append(6, 7, 8, 9)
+
+	expected = &slice.Slice[int]{7, 8, 9}
+	if result := r.ToSlice(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+
+	// Test case 4: Append to a ring without capacity.
+	r = &slice.RingSlice[int]{}
+	r.Append(1)
+	if length := r.Length(); length != 0 {
+		t.Errorf("Expected length 0, but got %d", length)
+	}
+}
+
+func TestRingDrain(t *testing.T) {
+	// Test case: Drain the ring into a slice and reuse it.
+	r := slice.NewRing[int](3, 1, 2, 3, 4)
+	result := r.Drain()
+
+	expected := &slice.Slice[int]{2, 3, 4}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+	if !r.IsEmpty() {
+		t.Errorf("Expected true, but got false")
+	}
+	r.Append(5)
+
+	expected = &slice.Slice[int]{5}
+	if result = r.ToSlice(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+}
+
+func TestRingEach(t *testing.T) {
+	// Test case 1: Visit values from oldest to newest.
+	r := slice.NewRing[int](3, 1, 2, 3, 4, 5)
+	var indices, values []int
+	r.Each(func(i int, value int) {
+		indices = append(indices, i)
+		values = append(values, value)
+	})
+	if !reflect.DeepEqual(indices, []int{0, 1, 2}) || !reflect.DeepEqual(values, []int{3, 4, 5}) {
+		t.Errorf("Expected %v and %v, but got %v and %v", []int{0, 1, 2}, []int{3, 4, 5}, indices, values)
+	}
+
+	// Test case 2: Visit values from newest to oldest.
+	indices, values = nil, nil
+	r.EachReverse(func(i int, value int) {
+		indices = append(indices, i)
+		values = append(values, value)
+	})
+	if !reflect.DeepEqual(indices, []int{2, 1, 0}) || !reflect.DeepEqual(values, []int{5, 4, 3}) {
+		t.Errorf("Expected %v and %v, but got %v and %v", []int{2, 1, 0}, []int{5, 4, 3}, indices, values)
+	}
+}
+
+func TestRingGet(t *testing.T) {
+	// Test case 1: Get values relative to the oldest value.
+	r := slice.NewRing[int](3, 1, 2, 3, 4)
+	if value, ok := r.Get(0); !ok || value != 2 {
+		t.Errorf("Expected 2 and true, but got %d and %v", value, ok)
+	}
+	if value := r.Fetch(2); value != 4 {
+		t.Errorf("Expected 4, but got %d", value)
+	}
+
+	// Test case 2: Get values out of bounds.
+	if _, ok := r.Get(3); ok {
+		t.Errorf("Expected false, but got true")
+	}
+	if value := r.Fetch(-1); value != 0 {
+		t.Errorf("Expected 0, but got %d", value)
+	}
+}
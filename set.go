@@ -0,0 +1,409 @@
+package slice
+
+import (
+	"iter"
+	"maps"
+	"slices"
+)
+
+// Set represents a generic unordered collection of distinct comparable values backed by a map.
+// Add, Remove and Has run in O(1) time. Use LinkedSet when the order values were added in matters.
+//
+// The zero value is an empty set ready to use.
+type Set[T comparable] struct {
+	values map[T]struct{}
+}
+
+// Add adds the given values to the set and returns the set.
+//
+//	set := slice.NewSet[int](1)
+//	set.Add(2, 3, 1)
+//	fmt.Println(set.Length()) // 3
+func (set *Set[T]) Add(values ...T) *Set[T] {
+	if set.values == nil {
+		set.values = make(map[T]struct{}, len(values))
+	}
+	for _, value := range values {
+		set.values[value] = struct{}{}
+	}
+	return set
+}
+
+// Clone returns a new set containing the values of the set.
+func (set *Set[T]) Clone() *Set[T] {
+	return &Set[T]{values: maps.Clone(set.values)}
+}
+
+// Difference returns a new set containing the values of the set that are not in the other set.
+//
+//	a := slice.NewSet[int](1, 2, 3)
+//	b := slice.NewSet[int](2)
+//	fmt.Println(a.Difference(b).ToSortedSlice(cmp.Compare[int])) // &[1, 3]
+func (set *Set[T]) Difference(otherSet *Set[T]) *Set[T] {
+	newSet := &Set[T]{}
+	for value := range set.values {
+		if !otherSet.Has(value) {
+			newSet.Add(value)
+		}
+	}
+	return newSet
+}
+
+// Disjoint checks if the set and the other set have no values in common.
+func (set *Set[T]) Disjoint(otherSet *Set[T]) bool {
+	smaller, larger := set, otherSet
+	if larger.Length() < smaller.Length() {
+		smaller, larger = larger, smaller
+	}
+	for value := range smaller.values {
+		if larger.Has(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Each applies the given function to each value of the set, in no particular order, and returns the set.
+func (set *Set[T]) Each(fn func(value T)) *Set[T] {
+	for value := range set.values {
+		fn(value)
+	}
+	return set
+}
+
+// Equal checks if the set and the other set contain the same values.
+func (set *Set[T]) Equal(otherSet *Set[T]) bool {
+	return set.Length() == otherSet.Length() && set.IsSubset(otherSet)
+}
+
+// Has checks if the set contains the given value.
+//
+//	set := slice.NewSet[int](1, 2)
+//	fmt.Println(set.Has(2)) // true
+func (set *Set[T]) Has(value T) bool {
+	_, ok := set.values[value]
+	return ok
+}
+
+// Intersect returns a new set containing the values that are in both the set and the other set.
+func (set *Set[T]) Intersect(otherSet *Set[T]) *Set[T] {
+	smaller, larger := set, otherSet
+	if larger.Length() < smaller.Length() {
+		smaller, larger = larger, smaller
+	}
+	newSet := &Set[T]{}
+	for value := range smaller.values {
+		if larger.Has(value) {
+			newSet.Add(value)
+		}
+	}
+	return newSet
+}
+
+// IsEmpty returns true if the set is empty, or false otherwise.
+func (set *Set[T]) IsEmpty() bool {
+	return set.Length() == 0
+}
+
+// IsSubset checks if every value of the set is also in the other set.
+func (set *Set[T]) IsSubset(otherSet *Set[T]) bool {
+	if set.Length() > otherSet.Length() {
+		return false
+	}
+	for value := range set.values {
+		if !otherSet.Has(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset checks if every value of the other set is also in the set.
+func (set *Set[T]) IsSuperset(otherSet *Set[T]) bool {
+	return otherSet.IsSubset(set)
+}
+
+// Length returns the number of values in the set.
+func (set *Set[T]) Length() int {
+	return len(set.values)
+}
+
+// Remove removes the given values from the set and returns the set.
+func (set *Set[T]) Remove(values ...T) *Set[T] {
+	for _, value := range values {
+		delete(set.values, value)
+	}
+	return set
+}
+
+// SymmetricDifference returns a new set containing the values that are in exactly one of the set and the other set.
+func (set *Set[T]) SymmetricDifference(otherSet *Set[T]) *Set[T] {
+	newSet := set.Difference(otherSet)
+	for value := range otherSet.values {
+		if !set.Has(value) {
+			newSet.Add(value)
+		}
+	}
+	return newSet
+}
+
+// ToSlice returns a new slice containing the values of the set in no particular order.
+func (set *Set[T]) ToSlice() *Slice[T] {
+	newSlice := make(Slice[T], 0, len(set.values))
+	for value := range set.values {
+		newSlice = append(newSlice, value)
+	}
+	return &newSlice
+}
+
+// ToSortedSlice returns a new slice containing the values of the set sorted with the provided comparison function,
+// which returns a negative number when a < b, a positive number when a > b and zero otherwise (such as cmp.Compare).
+//
+//	set := slice.NewSet[int](3, 1, 2)
+//	fmt.Println(set.ToSortedSlice(cmp.Compare[int])) // &[1, 2, 3]
+func (set *Set[T]) ToSortedSlice(cmp func(a T, b T) int) *Slice[T] {
+	newSlice := set.ToSlice()
+	slices.SortFunc(*newSlice, cmp)
+	return newSlice
+}
+
+// Union returns a new set containing the values that are in the set, the other set or both.
+func (set *Set[T]) Union(otherSet *Set[T]) *Set[T] {
+	newSet := set.Clone()
+	for value := range otherSet.values {
+		newSet.Add(value)
+	}
+	return newSet
+}
+
+// Values returns an iterator over the values of the set in no particular order.
+func (set *Set[T]) Values() iter.Seq[T] {
+	return maps.Keys(set.values)
+}
+
+// FromSlice creates a new instance of the Set[T] type containing the distinct values of the given slice.
+//
+//	newSlice := slice.New[int](1, 2, 2, 3)
+//	set := slice.FromSlice(newSlice)
+//	fmt.Println(set.Length()) // 3
+func FromSlice[T comparable](s *Slice[T]) *Set[T] {
+	return NewSet(*s...)
+}
+
+// NewSet creates a new instance of the Set[T] type and initializes it with the provided values.
+func NewSet[T comparable](values ...T) *Set[T] {
+	return (&Set[T]{}).Add(values...)
+}
+
+// LinkedSet represents a generic collection of distinct comparable values that remembers the order they were added in.
+// Like Slice.Deduplicate, adding a value that is already present keeps it at its original position.
+// Add, Remove and Has run in O(1) time, and every method that produces values produces them in insertion order.
+// Set operations keep the order of the receiver followed by the order of the other set.
+//
+// The zero value is an empty set ready to use.
+type LinkedSet[T comparable] struct {
+	nodes map[T]*linkedSetNode[T]
+	head  *linkedSetNode[T]
+	tail  *linkedSetNode[T]
+}
+
+// Add adds the given values that are not already present to the end of the set and returns the set.
+//
+//	set := slice.NewLinkedSet[int](3, 1)
+//	set.Add(2, 3)
+//	fmt.Println(set.ToSlice()) // &[3, 1, 2]
+func (set *LinkedSet[T]) Add(values ...T) *LinkedSet[T] {
+	if set.nodes == nil {
+		set.nodes = make(map[T]*linkedSetNode[T], len(values))
+	}
+	for _, value := range values {
+		if _, ok := set.nodes[value]; ok {
+			continue
+		}
+		node := &linkedSetNode[T]{value: value, previous: set.tail}
+		if set.tail == nil {
+			set.head = node
+		} else {
+			set.tail.next = node
+		}
+		set.tail = node
+		set.nodes[value] = node
+	}
+	return set
+}
+
+// Clone returns a new set containing the values of the set in the same order.
+func (set *LinkedSet[T]) Clone() *LinkedSet[T] {
+	return set.filter(func(T) bool {
+		return true
+	})
+}
+
+// Difference returns a new set containing the values of the set that are not in the other set, in the order of the set.
+//
+//	a := slice.NewLinkedSet[int](3, 1, 2)
+//	b := slice.NewLinkedSet[int](1)
+//	fmt.Println(a.Difference(b).ToSlice()) // &[3, 2]
+func (set *LinkedSet[T]) Difference(otherSet *LinkedSet[T]) *LinkedSet[T] {
+	return set.filter(func(value T) bool {
+		return !otherSet.Has(value)
+	})
+}
+
+// Disjoint checks if the set and the other set have no values in common.
+func (set *LinkedSet[T]) Disjoint(otherSet *LinkedSet[T]) bool {
+	for node := set.head; node != nil; node = node.next {
+		if otherSet.Has(node.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Each applies the given function to each value of the set in insertion order and returns the set.
+func (set *LinkedSet[T]) Each(fn func(value T)) *LinkedSet[T] {
+	for node := set.head; node != nil; node = node.next {
+		fn(node.value)
+	}
+	return set
+}
+
+// Equal checks if the set and the other set contain the same values, regardless of order.
+func (set *LinkedSet[T]) Equal(otherSet *LinkedSet[T]) bool {
+	return set.Length() == otherSet.Length() && set.IsSubset(otherSet)
+}
+
+// Has checks if the set contains the given value.
+func (set *LinkedSet[T]) Has(value T) bool {
+	_, ok := set.nodes[value]
+	return ok
+}
+
+// Intersect returns a new set containing the values that are in both the set and the other set, in the order of the set.
+func (set *LinkedSet[T]) Intersect(otherSet *LinkedSet[T]) *LinkedSet[T] {
+	return set.filter(otherSet.Has)
+}
+
+// IsEmpty returns true if the set is empty, or false otherwise.
+func (set *LinkedSet[T]) IsEmpty() bool {
+	return set.Length() == 0
+}
+
+// IsSubset checks if every value of the set is also in the other set.
+func (set *LinkedSet[T]) IsSubset(otherSet *LinkedSet[T]) bool {
+	if set.Length() > otherSet.Length() {
+		return false
+	}
+	for node := set.head; node != nil; node = node.next {
+		if !otherSet.Has(node.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset checks if every value of the other set is also in the set.
+func (set *LinkedSet[T]) IsSuperset(otherSet *LinkedSet[T]) bool {
+	return otherSet.IsSubset(set)
+}
+
+// Length returns the number of values in the set.
+func (set *LinkedSet[T]) Length() int {
+	return len(set.nodes)
+}
+
+// Remove removes the given values from the set and returns the set.
+func (set *LinkedSet[T]) Remove(values ...T) *LinkedSet[T] {
+	for _, value := range values {
+		node, ok := set.nodes[value]
+		if !ok {
+			continue
+		}
+		if node.previous == nil {
+			set.head = node.next
+		} else {
+			node.previous.next = node.next
+		}
+		if node.next == nil {
+			set.tail = node.previous
+		} else {
+			node.next.previous = node.previous
+		}
+		delete(set.nodes, value)
+	}
+	return set
+}
+
+// SymmetricDifference returns a new set containing the values that are in exactly one of the set and the other set:
+// those of the set in its order, followed by those of the other set in its order.
+func (set *LinkedSet[T]) SymmetricDifference(otherSet *LinkedSet[T]) *LinkedSet[T] {
+	newSet := set.Difference(otherSet)
+	for node := otherSet.head; node != nil; node = node.next {
+		if !set.Has(node.value) {
+			newSet.Add(node.value)
+		}
+	}
+	return newSet
+}
+
+// ToSlice returns a new slice containing the values of the set in insertion order.
+func (set *LinkedSet[T]) ToSlice() *Slice[T] {
+	newSlice := make(Slice[T], 0, len(set.nodes))
+	for node := set.head; node != nil; node = node.next {
+		newSlice = append(newSlice, node.value)
+	}
+	return &newSlice
+}
+
+// Union returns a new set containing the values of the set followed by the values of the other set that are not in it.
+func (set *LinkedSet[T]) Union(otherSet *LinkedSet[T]) *LinkedSet[T] {
+	newSet := set.Clone()
+	for node := otherSet.head; node != nil; node = node.next {
+		newSet.Add(node.value)
+	}
+	return newSet
+}
+
+// Values returns an iterator over the values of the set in insertion order.
+func (set *LinkedSet[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := set.head; node != nil; node = node.next {
+			if !yield(node.value) {
+				return
+			}
+		}
+	}
+}
+
+// filter returns a new set containing the values of the set that satisfy fn, in the order of the set.
+func (set *LinkedSet[T]) filter(fn func(value T) bool) *LinkedSet[T] {
+	newSet := &LinkedSet[T]{}
+	for node := set.head; node != nil; node = node.next {
+		if fn(node.value) {
+			newSet.Add(node.value)
+		}
+	}
+	return newSet
+}
+
+// linkedSetNode is an entry of a LinkedSet.
+type linkedSetNode[T comparable] struct {
+	value    T
+	previous *linkedSetNode[T]
+	next     *linkedSetNode[T]
+}
+
+// LinkedSetFromSlice creates a new instance of the LinkedSet[T] type containing the distinct values of the given slice
+// in the order they first appear.
+//
+//	newSlice := slice.New[int](3, 1, 3, 2)
+//	set := slice.LinkedSetFromSlice(newSlice)
+//	fmt.Println(set.ToSlice()) // &[3, 1, 2]
+func LinkedSetFromSlice[T comparable](s *Slice[T]) *LinkedSet[T] {
+	return NewLinkedSet(*s...)
+}
+
+// NewLinkedSet creates a new instance of the LinkedSet[T] type and initializes it with the provided values.
+func NewLinkedSet[T comparable](values ...T) *LinkedSet[T] {
+	return (&LinkedSet[T]{}).Add(values...)
+}
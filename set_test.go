@@ -0,0 +1,126 @@
+package slice_test
+
+import (
+	"cmp"
+	"reflect"
+	"testing"
+
+	"github.com/lindsaygelle/slice"
+)
+
+func TestSetAddRemoveHas(t *testing.T) {
+	// Test case 1: The zero value is ready to use.
+	set := &slice.Set[int]{}
+	if set.Has(1) || !set.IsEmpty() {
+		t.Errorf("Expected an empty set")
+	}
+	set.Add(1, 2, 2, 3).Remove(2, 4)
+
+	if !set.Has(1) || set.Has(2) || set.Length() != 2 {
+		t.Errorf("Expected {1, 3}, but got %v", set.ToSortedSlice(cmp.Compare[int]))
+	}
+
+	// Test case 2: Create a set from a slice.
+	set = slice.FromSlice(slice.New(3, 1, 3, 2))
+	expected := &slice.Slice[int]{1, 2, 3}
+	if result := set.ToSortedSlice(cmp.Compare[int]); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+}
+
+func TestSetAlgebra(t *testing.T) {
+	a, b := slice.NewSet(1, 2, 3), slice.NewSet(3, 4)
+	sorted := func(set *slice.Set[int]) *slice.Slice[int] {
+		return set.ToSortedSlice(cmp.Compare[int])
+	}
+
+	tests := []struct {
+		name     string
+		result   *slice.Slice[int]
+		expected *slice.Slice[int]
+	}{
+		{"Union", sorted(a.Union(b)), &slice.Slice[int]{1, 2, 3, 4}},
+		{"Intersect", sorted(a.Intersect(b)), &slice.Slice[int]{3}},
+		{"Difference", sorted(a.Difference(b)), &slice.Slice[int]{1, 2}},
+		{"SymmetricDifference", sorted(a.SymmetricDifference(b)), &slice.Slice[int]{1, 2, 4}},
+	}
+	for _, test := range tests {
+		if !reflect.DeepEqual(test.result, test.expected) {
+			t.Errorf("%s: expected %v, but got %v", test.name, test.expected, test.result)
+		}
+	}
+
+	if !slice.NewSet(1, 2).IsSubset(a) || a.IsSubset(b) || !a.IsSuperset(slice.NewSet(1)) {
+		t.Errorf("Unexpected subset result")
+	}
+	if a.Disjoint(b) || !a.Disjoint(slice.NewSet(5)) {
+		t.Errorf("Unexpected disjoint result")
+	}
+	if !a.Equal(slice.NewSet(3, 2, 1)) || a.Equal(b) {
+		t.Errorf("Unexpected equal result")
+	}
+	if result := sorted(a); !reflect.DeepEqual(result, &slice.Slice[int]{1, 2, 3}) {
+		t.Errorf("Expected the receiver to be unchanged, but got %v", result)
+	}
+}
+
+func TestLinkedSet(t *testing.T) {
+	// Test case 1: Values keep their first insertion order.
+	set := slice.LinkedSetFromSlice(slice.New(3, 1, 3, 2))
+	set.Add(1, 5)
+
+	expected := &slice.Slice[int]{3, 1, 2, 5}
+	if result := set.ToSlice(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+
+	// Test case 2: Remove from the head, middle and tail, then add again at the end.
+	set.Remove(3, 2, 5).Add(3)
+
+	expected = &slice.Slice[int]{1, 3}
+	if result := set.ToSlice(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+	var values []int
+	for value := range set.Values() {
+		values = append(values, value)
+	}
+	if !reflect.DeepEqual(values, []int{1, 3}) {
+		t.Errorf("Expected [1 3], but got %v", values)
+	}
+
+	// Test case 3: The zero value is ready to use.
+	empty := &slice.LinkedSet[string]{}
+	empty.Remove("a")
+	if !empty.IsEmpty() || empty.Has("a") {
+		t.Errorf("Expected an empty set")
+	}
+}
+
+func TestLinkedSetAlgebra(t *testing.T) {
+	a, b := slice.NewLinkedSet(4, 1, 3), slice.NewLinkedSet(5, 3, 2)
+
+	tests := []struct {
+		name     string
+		result   *slice.Slice[int]
+		expected *slice.Slice[int]
+	}{
+		{"Union", a.Union(b).ToSlice(), &slice.Slice[int]{4, 1, 3, 5, 2}},
+		{"Intersect", a.Intersect(b).ToSlice(), &slice.Slice[int]{3}},
+		{"Difference", a.Difference(b).ToSlice(), &slice.Slice[int]{4, 1}},
+		{"SymmetricDifference", a.SymmetricDifference(b).ToSlice(), &slice.Slice[int]{4, 1, 5, 2}},
+		{"Clone", a.Clone().ToSlice(), &slice.Slice[int]{4, 1, 3}},
+	}
+	for _, test := range tests {
+		if !reflect.DeepEqual(test.result, test.expected) {
+			t.Errorf("%s: expected %v, but got %v", test.name, test.expected, test.result)
+		}
+	}
+
+	if !slice.NewLinkedSet(3, 4).IsSubset(a) || !a.IsSuperset(slice.NewLinkedSet(1)) || a.Disjoint(b) {
+		t.Errorf("Unexpected subset or disjoint result")
+	}
+	if !a.Equal(slice.NewLinkedSet(1, 3, 4)) {
+		t.Errorf("Expected sets with the same values in a different order to be equal")
+	}
+}
@@ -2,6 +2,8 @@ package slice
 
 import (
 	"fmt"
+	"iter"
+	"math"
 	"math/rand"
 	"reflect"
 	"sort"
@@ -10,6 +12,29 @@ import (
 // Slice represents a generic slice of any data type.
 type Slice[T any] []T
 
+// Omit stands for an omitted start or stop index in SliceRange and DeleteRange, like leaving out a bound of a Python slice.
+const Omit = math.MinInt
+
+// All returns an iterator over the index-value pairs of the slice in order.
+//
+//	newSlice := &slice.Slice[int]{1, 2, 3}
+//	for i, value := range newSlice.All() {
+//	    fmt.Println(i, value)
+//	}
+//	// Output:
+//	// 0 1
+//	// 1 2
+//	// 2 3
+func (slice *Slice[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, value := range *slice {
+			if !yield(i, value) {
+				return
+			}
+		}
+	}
+}
+
 // Append appends the given values to the slice and returns the modified slice.
 //
 //	newSlice := &slice.Slice[int]{}
@@ -46,6 +71,39 @@ func (slice *Slice[T]) AppendLength(values ...T) int {
 	return slice.Append(values...).Length()
 }
 
+// At returns the element at the specified index and a boolean indicating whether the index is valid.
+// Unlike Get, a negative index counts back from the end of the slice, so -1 is the last element.
+//
+//	newSlice := &slice.Slice[int]{1, 2, 3}
+//	value, found := newSlice.At(-1)
+//	fmt.Println(value, found) // 3, true
+func (slice *Slice[T]) At(i int) (T, bool) {
+	if i < 0 {
+		i += len(*slice)
+	}
+	return slice.Get(i)
+}
+
+// Backward returns an iterator over the index-value pairs of the slice in reverse order.
+//
+//	newSlice := &slice.Slice[int]{1, 2, 3}
+//	for i, value := range newSlice.Backward() {
+//	    fmt.Println(i, value)
+//	}
+//	// Output:
+//	// 2 3
+//	// 1 2
+//	// 0 1
+func (slice *Slice[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := slice.Length() - 1; i >= 0; i-- {
+			if !yield(i, (*slice)[i]) {
+				return
+			}
+		}
+	}
+}
+
 // Bounds checks if the given index is within the bounds of the slice.
 //
 //	newSlice := &slice.Slice[int]{1, 2, 3}
@@ -65,6 +123,18 @@ func (slice *Slice[T]) Clone() *Slice[T] {
 	return &newSlice
 }
 
+// Collect appends the values yielded by the given iterator to the slice and returns the modified slice.
+//
+//	newSlice := &slice.Slice[int]{1}
+//	newSlice.Collect(slices.Values([]int{2, 3}))
+//	fmt.Println(newSlice) // &[1, 2, 3]
+func (slice *Slice[T]) Collect(seq iter.Seq[T]) *Slice[T] {
+	for value := range seq {
+		slice.Append(value)
+	}
+	return slice
+}
+
 // Concatenate concatenates the given slice with the original slice and returns the modified slice.
 //
 //	slice1 := &slice.Slice[int]{1, 2}
@@ -78,6 +148,39 @@ func (slice *Slice[T]) Concatenate(otherSlice *Slice[T]) *Slice[T] {
 	return slice
 }
 
+// ConcatenateAll merges the elements of the given slices to the tail of the slice, in order, and returns the modified slice.
+// The slice grows at most once, however many slices are given. Nil slices are ignored.
+//
+//	slice1 := &slice.Slice[int]{1}
+//	slice2 := &slice.Slice[int]{2, 3}
+//	slice3 := &slice.Slice[int]{4}
+//	slice1.ConcatenateAll(slice2, slice3)
+//	fmt.Println(slice1) // &[1, 2, 3, 4]
+func (slice *Slice[T]) ConcatenateAll(otherSlices ...*Slice[T]) *Slice[T] {
+	original := len(*slice)
+	length := original
+	for _, otherSlice := range otherSlices {
+		if otherSlice != nil {
+			length += len(*otherSlice)
+		}
+	}
+	if length > cap(*slice) {
+		newSlice := make(Slice[T], original, length)
+		copy(newSlice, *slice)
+		*slice = newSlice
+	}
+	for _, otherSlice := range otherSlices {
+		switch otherSlice {
+		case nil:
+		case slice:
+			*slice = append(*slice, (*slice)[:original]...) // The slice may be given more than once.
+		default:
+			*slice = append(*slice, *otherSlice...)
+		}
+	}
+	return slice
+}
+
 // ConcatenateFunc concatenates values based on the provided function and returns the modified slice.
 //
 //	slice1 := &slice.Slice[int]{1, 2, 3}
@@ -202,6 +305,34 @@ func (slice *Slice[T]) DeleteOK(i int) bool {
 	return false
 }
 
+// DeleteRange removes the elements that SliceRange would return for the same arguments and returns the modified slice,
+// like the Python statement del list[start:stop:step]. It never panics; a step of 0 removes nothing.
+//
+//	newSlice := &slice.Slice[int]{0, 1, 2, 3, 4}
+//	newSlice.DeleteRange(slice.Omit, slice.Omit, 2)
+//	fmt.Println(newSlice) // &[1, 3]
+func (slice *Slice[T]) DeleteRange(start int, stop int, step int) *Slice[T] {
+	start, count := rangeIndices(len(*slice), start, stop, step)
+	if count == 0 {
+		return slice
+	}
+	if step < 0 {
+		start, step = start+(count-1)*step, -step // Delete the same elements in ascending order.
+	}
+	last := start + (count-1)*step
+	j := start
+	for i := start; i < len(*slice); i++ {
+		if i <= last && (i-start)%step == 0 {
+			continue
+		}
+		(*slice)[j] = (*slice)[i]
+		j++
+	}
+	clear((*slice)[j:])
+	*slice = (*slice)[:j]
+	return slice
+}
+
 // DeleteUnsafe removes the element at the specified index from the slice without performing bounds checks and returns the modified slice.
 //
 //	newSlice := &slice.Slice[int]{1, 2, 3, 4, 5}
@@ -438,6 +569,26 @@ func (slice *Slice[T]) GetLength(i int) (T, bool, int) {
 	return value, ok, slice.Length()
 }
 
+// Indices returns an iterator over the indices of the slice in order.
+//
+//	newSlice := &slice.Slice[string]{"a", "b", "c"}
+//	for i := range newSlice.Indices() {
+//	    fmt.Println(i)
+//	}
+//	// Output:
+//	// 0
+//	// 1
+//	// 2
+func (slice *Slice[T]) Indices() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := range *slice {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
 // IsEmpty returns true if the slice is empty, or false otherwise.
 //
 //	newSlice := &slice.Slice[int]{}
@@ -554,6 +705,19 @@ func (slice *Slice[T]) ModifyReverse(fn func(i int, value T) T) *Slice[T] {
 	return slice
 }
 
+// Pipeline returns a lazy pipeline that reads the values of the slice. See Pipeline for the available stages.
+//
+//	newSlice := &slice.Slice[int]{1, 2, 3, 4, 5}
+//	result := newSlice.Pipeline().Filter(func(value int) bool {
+//	    return value%2 == 1
+//	}).Map(func(value int) int {
+//	    return value * 10
+//	}).Collect()
+//	fmt.Println(result) // &[10, 30, 50]
+func (slice *Slice[T]) Pipeline() *Pipeline[T] {
+	return NewPipeline(slice.Values())
+}
+
 // Poll removes and returns the first element of the slice, or a zero value if the slice is empty.
 //
 //	newSlice := &slice.Slice[int]{1, 2, 3, 4, 5}
@@ -781,6 +945,38 @@ func (slice *Slice[T]) Slice(i int, j int) *Slice[T] {
 	return &newSlice
 }
 
+// SliceRange returns a new slice containing the elements from index start up to, but not including, index stop,
+// taking every step-th element, like the Python expression list[start:stop:step].
+// Negative indices count back from the end of the slice, and a negative step walks the slice backwards.
+// Use Omit for a bound to leave it out: it means the start of the walk for start and the end of the walk for stop.
+// Out-of-range indices are clamped, so SliceRange never panics. A step of 0 returns an empty slice.
+//
+// For the slice &[0, 1, 2, 3, 4]:
+//
+//	start  stop  step  result
+//	0      Omit  1     &[0, 1, 2, 3, 4]
+//	1      3     1     &[1, 2]
+//	-2     Omit  1     &[3, 4]
+//	Omit   -2    1     &[0, 1, 2]
+//	-10    10    1     &[0, 1, 2, 3, 4]
+//	3      1     1     &[]
+//	Omit   Omit  2     &[0, 2, 4]
+//	1      Omit  3     &[1, 4]
+//	Omit   Omit  -1    &[4, 3, 2, 1, 0]
+//	3      0     -1    &[3, 2, 1]
+//	-1     -4    -2    &[4, 2]
+//	10     Omit  -2    &[4, 2, 0]
+//	-10    Omit  -1    &[]
+//	Omit   Omit  0     &[]
+func (slice *Slice[T]) SliceRange(start int, stop int, step int) *Slice[T] {
+	start, count := rangeIndices(len(*slice), start, stop, step)
+	newSlice := make(Slice[T], count)
+	for i := range newSlice {
+		newSlice[i] = (*slice)[start+i*step]
+	}
+	return &newSlice
+}
+
 // SortFunc sorts the elements of the slice based on the provided comparison function and returns the modified slice.
 //
 //	newSlice := &slice.Slice[int]{5, 2, 1, 4, 3}
@@ -868,7 +1064,153 @@ func (slice *Slice[T]) Swap(i int, j int) {
 	}
 }
 
+// Values returns an iterator over the values of the slice in order.
+//
+//	newSlice := &slice.Slice[int]{1, 2, 3}
+//	values := slices.Collect(newSlice.Values())
+//	fmt.Println(values) // [1, 2, 3]
+func (slice *Slice[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, value := range *slice {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
 // New creates a new instance of the Slice[T] type and initializes it with the provided values.
 func New[T any](values ...T) *Slice[T] {
 	return (&Slice[T]{}).Append(values...)
 }
+
+// FromSeq creates a new instance of the Slice[T] type and initializes it with the values yielded by the given iterator.
+func FromSeq[T any](seq iter.Seq[T]) *Slice[T] {
+	return (&Slice[T]{}).Collect(seq)
+}
+
+// FromSeq2 creates a new instance of the Slice[T] type and initializes it with the values of the pairs yielded by the given iterator.
+func FromSeq2[K any, T any](seq iter.Seq2[K, T]) *Slice[T] {
+	newSlice := &Slice[T]{}
+	for _, value := range seq {
+		newSlice.Append(value)
+	}
+	return newSlice
+}
+
+// rangeIndices resolves the arguments of SliceRange for a slice of the given length the way Python does,
+// and returns the first selected index and the number of selected elements.
+func rangeIndices(length int, start int, stop int, step int) (int, int) {
+	if step == 0 {
+		return 0, 0
+	}
+	lower, upper := 0, length // The clamping range of a forward walk.
+	if step < 0 {
+		lower, upper = -1, length-1
+	}
+	resolve := func(i int, omitted int) int {
+		switch {
+		case i == Omit:
+			return omitted
+		case i < 0:
+			return max(i+length, lower)
+		default:
+			return min(i, upper)
+		}
+	}
+	if step > 0 {
+		start, stop = resolve(start, lower), resolve(stop, upper)
+		if start >= stop {
+			return start, 0
+		}
+		return start, (stop-start-1)/step + 1
+	}
+	start, stop = resolve(start, upper), resolve(stop, lower)
+	if start <= stop {
+		return start, 0
+	}
+	return start, (start-stop-1)/-step + 1
+}
+
+// FillPolicy decides how Transpose handles rows of different lengths.
+type FillPolicy int
+
+const (
+	// FillPad pads the short rows with the fill value, so every column is as long as the number of rows.
+	FillPad FillPolicy = iota
+	// FillSkip skips the missing elements, so each column holds only the rows that are long enough.
+	FillSkip
+	// FillTruncate drops the elements past the length of the shortest row.
+	FillTruncate
+)
+
+// Flatten concatenates the given slices into a new slice with a single allocation. Nil slices are ignored.
+//
+//	matrix := &slice.Slice[*slice.Slice[int]]{{1, 2}, {3}, {4, 5}}
+//	fmt.Println(slice.Flatten(matrix)) // &[1, 2, 3, 4, 5]
+func Flatten[T any](matrix *Slice[*Slice[T]]) *Slice[T] {
+	return (&Slice[T]{}).ConcatenateAll(*matrix...)
+}
+
+// Interleave returns a new slice with the elements of the given slices in round-robin order: the first element of each
+// slice, then the second element of each slice, and so on. Slices that run out of elements are skipped. Nil slices are ignored.
+//
+//	slice1 := &slice.Slice[int]{1, 2, 3}
+//	slice2 := &slice.Slice[int]{10}
+//	slice3 := &slice.Slice[int]{100, 200}
+//	fmt.Println(slice.Interleave(slice1, slice2, slice3)) // &[1, 10, 100, 2, 200, 3]
+func Interleave[T any](slices ...*Slice[T]) *Slice[T] {
+	var length, longest int
+	for _, otherSlice := range slices {
+		if otherSlice != nil {
+			length += len(*otherSlice)
+			longest = max(longest, len(*otherSlice))
+		}
+	}
+	newSlice := make(Slice[T], 0, length)
+	for i := 0; i < longest; i++ {
+		for _, otherSlice := range slices {
+			if otherSlice != nil && i < len(*otherSlice) {
+				newSlice = append(newSlice, (*otherSlice)[i])
+			}
+		}
+	}
+	return &newSlice
+}
+
+// Transpose returns a new matrix whose rows are the columns of the given matrix, so element j of row i becomes
+// element i of row j. The policy decides what happens when the rows have different lengths; fill is only used
+// by FillPad. A nil row is treated as an empty row.
+//
+//	matrix := &slice.Slice[*slice.Slice[int]]{{1, 2, 3}, {4}}
+//	fmt.Println(slice.Transpose(matrix, slice.FillPad, 0)) // &[&[1, 4], &[2, 0], &[3, 0]]
+//	fmt.Println(slice.Transpose(matrix, slice.FillSkip, 0)) // &[&[1, 4], &[2], &[3]]
+//	fmt.Println(slice.Transpose(matrix, slice.FillTruncate, 0)) // &[&[1, 4]]
+func Transpose[T any](matrix *Slice[*Slice[T]], policy FillPolicy, fill T) *Slice[*Slice[T]] {
+	var columns int
+	for i, row := range *matrix {
+		length := 0
+		if row != nil {
+			length = len(*row)
+		}
+		if policy == FillTruncate && i > 0 {
+			columns = min(columns, length)
+		} else {
+			columns = max(columns, length)
+		}
+	}
+	newMatrix := make(Slice[*Slice[T]], columns)
+	for j := range newMatrix {
+		column := make(Slice[T], 0, len(*matrix))
+		for _, row := range *matrix {
+			switch {
+			case row != nil && j < len(*row):
+				column = append(column, (*row)[j])
+			case policy == FillPad:
+				column = append(column, fill)
+			}
+		}
+		newMatrix[j] = &column
+	}
+	return &newMatrix
+}
@@ -1,12 +1,38 @@
 package slice_test
 
 import (
+	"maps"
+	"math"
 	"reflect"
+	"slices"
 	"testing"
 
 	"github.com/lindsaygelle/slice"
 )
 
+func TestAll(t *testing.T) {
+	// Test case 1: Range over the index-value pairs of the slice.
+	s := &slice.Slice[string]{"a", "b", "c"}
+	result := maps.Collect(s.All())
+
+	expected := map[int]string{0: "a", 1: "b", 2: "c"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+
+	// Test case 2: Stop ranging early.
+	var indices []int
+	for i := range s.All() {
+		if i == 1 {
+			break
+		}
+		indices = append(indices, i)
+	}
+	if !reflect.DeepEqual(indices, []int{0}) {
+		t.Errorf("Expected %v, but got %v", []int{0}, indices)
+	}
+}
+
 func TestAppend(t *testing.T) {
 	// Test case 1: Append values to an empty slice.
 	s := &slice.Slice[int]{}
@@ -79,6 +105,56 @@ func TestAppendLength(t *testing.T) {
 	}
 }
 
+func TestAt(t *testing.T) {
+	s := &slice.Slice[int]{1, 2, 3}
+	testCases := []struct {
+		i        int
+		expected int
+		ok       bool
+	}{
+		// Test case 1: A non-negative index.
+		{0, 1, true},
+		// Test case 2: A negative index counts from the end.
+		{-1, 3, true},
+		// Test case 3: The most negative valid index.
+		{-3, 1, true},
+		// Test case 4: A negative index past the start.
+		{-4, 0, false},
+		// Test case 5: An index past the end.
+		{3, 0, false},
+	}
+
+	for i, test := range testCases {
+		value, ok := s.At(test.i)
+		if value != test.expected || ok != test.ok {
+			t.Errorf("Test case %d: Expected %v, %v, but got %v, %v", i+1, test.expected, test.ok, value, ok)
+		}
+	}
+}
+
+func TestBackward(t *testing.T) {
+	// Test case 1: Range over the index-value pairs of the slice in reverse order.
+	s := &slice.Slice[int]{1, 2, 3}
+	var indices, values []int
+	for i, value := range s.Backward() {
+		indices = append(indices, i)
+		values = append(values, value)
+	}
+	if !reflect.DeepEqual(indices, []int{2, 1, 0}) || !reflect.DeepEqual(values, []int{3, 2, 1}) {
+		t.Errorf("Expected %v and %v, but got %v and %v", []int{2, 1, 0}, []int{3, 2, 1}, indices, values)
+	}
+
+	// Test case 2: Stop ranging early.
+	values = nil
+	for _, value := range s.Backward() {
+		values = append(values, value)
+		break
+	}
+	if !reflect.DeepEqual(values, []int{3}) {
+		t.Errorf("Expected %v, but got %v", []int{3}, values)
+	}
+}
+
 func TestBounds(t *testing.T) {
 	// Test case 1: Check index within bounds.
 	s := &slice.Slice[int]{1, 2, 3, 4, 5}
@@ -112,6 +188,20 @@ func TestClone(t *testing.T) {
 	}
 }
 
+func TestCollect(t *testing.T) {
+	// Test case: Append the values of an iterator to the slice.
+	s := &slice.Slice[int]{1}
+	result := s.Collect(slices.Values([]int{2, 3}))
+
+	expected := &slice.Slice[int]{1, 2, 3}
+	if !reflect.DeepEqual(s, expected) {
+		t.Errorf("Expected %v, but got %v", expected, s)
+	}
+	if result != s {
+		t.Errorf("Expected result to be the same slice, but got a different slice")
+	}
+}
+
 func TestConcatenate(t *testing.T) {
 	// Test case 1: Concatenate with nil slice.
 	s := &slice.Slice[int]{1, 2, 3}
@@ -139,6 +229,39 @@ func TestConcatenate(t *testing.T) {
 	}
 }
 
+func TestConcatenateAll(t *testing.T) {
+	// Test case 1: Concatenate several slices, ignoring nil slices.
+	s := &slice.Slice[int]{1}
+	result := s.ConcatenateAll(&slice.Slice[int]{2, 3}, nil, &slice.Slice[int]{}, &slice.Slice[int]{4})
+
+	expected := &slice.Slice[int]{1, 2, 3, 4}
+	if !reflect.DeepEqual(s, expected) {
+		t.Errorf("Expected %v, but got %v", expected, s)
+	}
+	if result != s {
+		t.Errorf("Expected result to be the same slice, but got a different slice")
+	}
+
+	// Test case 2: Concatenate the slice with itself.
+	s = &slice.Slice[int]{1, 2}
+	s.ConcatenateAll(s, &slice.Slice[int]{3}, s)
+
+	expected = &slice.Slice[int]{1, 2, 1, 2, 3, 1, 2}
+	if !reflect.DeepEqual(s, expected) {
+		t.Errorf("Expected %v, but got %v", expected, s)
+	}
+
+	// Test case 3: The slice grows with a single allocation.
+	other := &slice.Slice[int]{1, 2, 3}
+	allocs := testing.AllocsPerRun(10, func() {
+		*s = (*s)[:0:0]
+		s.ConcatenateAll(other, other, other, other)
+	})
+	if allocs != 1 {
+		t.Errorf("Expected 1 allocation, but got %v", allocs)
+	}
+}
+
 func TestConcatenateFunc(t *testing.T) {
 	// Test case 1: Concatenate with nil slice using a function.
 	s := &slice.Slice[int]{1, 2, 3}
@@ -303,6 +426,19 @@ func TestDeleteOK(t *testing.T) {
 	}
 }
 
+func TestDeleteRange(t *testing.T) {
+	for i, test := range rangeTestCases {
+		s := &slice.Slice[int]{0, 1, 2, 3, 4}
+		result := s.DeleteRange(test.start, test.stop, test.step)
+		if !reflect.DeepEqual(s, test.deleted) {
+			t.Errorf("Test case %d: Expected %v, but got %v", i+1, test.deleted, s)
+		}
+		if result != s {
+			t.Errorf("Test case %d: Expected result to be the same slice, but got a different slice", i+1)
+		}
+	}
+}
+
 func TestDeleteUnsafe(t *testing.T) {
 	// Test case: Delete a value at a specific index without bounds checking.
 	s := &slice.Slice[int]{1, 2, 3, 4, 5}
@@ -558,6 +694,17 @@ func TestFetchLength(t *testing.T) {
 	}
 }
 
+func TestIndices(t *testing.T) {
+	// Test case: Range over the indices of the slice.
+	s := &slice.Slice[string]{"a", "b", "c"}
+	result := slices.Collect(s.Indices())
+
+	expected := []int{0, 1, 2}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+}
+
 func TestIsEmpty(t *testing.T) {
 	// Test case 1: Check if an empty slice is empty.
 	s := &slice.Slice[int]{}
@@ -1076,6 +1223,59 @@ func TestSlice(t *testing.T) {
 	}
 }
 
+// rangeTestCases is the truth table of SliceRange and DeleteRange for the slice &[0, 1, 2, 3, 4].
+// The expected values match the Python expressions list[start:stop:step] and del list[start:stop:step].
+var rangeTestCases = []struct {
+	start   int
+	stop    int
+	step    int
+	sliced  *slice.Slice[int]
+	deleted *slice.Slice[int]
+}{
+	{0, slice.Omit, 1, &slice.Slice[int]{0, 1, 2, 3, 4}, &slice.Slice[int]{}},
+	{1, 3, 1, &slice.Slice[int]{1, 2}, &slice.Slice[int]{0, 3, 4}},
+	{-2, slice.Omit, 1, &slice.Slice[int]{3, 4}, &slice.Slice[int]{0, 1, 2}},
+	{slice.Omit, -2, 1, &slice.Slice[int]{0, 1, 2}, &slice.Slice[int]{3, 4}},
+	{-10, 10, 1, &slice.Slice[int]{0, 1, 2, 3, 4}, &slice.Slice[int]{}},
+	{3, 1, 1, &slice.Slice[int]{}, &slice.Slice[int]{0, 1, 2, 3, 4}},
+	{slice.Omit, slice.Omit, 2, &slice.Slice[int]{0, 2, 4}, &slice.Slice[int]{1, 3}},
+	{1, slice.Omit, 3, &slice.Slice[int]{1, 4}, &slice.Slice[int]{0, 2, 3}},
+	{slice.Omit, slice.Omit, -1, &slice.Slice[int]{4, 3, 2, 1, 0}, &slice.Slice[int]{}},
+	{3, 0, -1, &slice.Slice[int]{3, 2, 1}, &slice.Slice[int]{0, 4}},
+	{-1, -4, -2, &slice.Slice[int]{4, 2}, &slice.Slice[int]{0, 1, 3}},
+	{10, slice.Omit, -2, &slice.Slice[int]{4, 2, 0}, &slice.Slice[int]{1, 3}},
+	{slice.Omit, -10, -1, &slice.Slice[int]{4, 3, 2, 1, 0}, &slice.Slice[int]{}},
+	{-10, slice.Omit, -1, &slice.Slice[int]{}, &slice.Slice[int]{0, 1, 2, 3, 4}},
+	{slice.Omit, slice.Omit, 0, &slice.Slice[int]{}, &slice.Slice[int]{0, 1, 2, 3, 4}},
+	{0, 5, math.MaxInt, &slice.Slice[int]{0}, &slice.Slice[int]{1, 2, 3, 4}},
+	{slice.Omit, slice.Omit, math.MinInt, &slice.Slice[int]{4}, &slice.Slice[int]{0, 1, 2, 3}},
+	{math.MaxInt, math.MinInt + 1, -1, &slice.Slice[int]{4, 3, 2, 1, 0}, &slice.Slice[int]{}},
+}
+
+func TestSliceRange(t *testing.T) {
+	// Test case 1: Every row of the truth table.
+	s := &slice.Slice[int]{0, 1, 2, 3, 4}
+	for i, test := range rangeTestCases {
+		result := s.SliceRange(test.start, test.stop, test.step)
+		if !reflect.DeepEqual(result, test.sliced) {
+			t.Errorf("Test case 1.%d: Expected %v, but got %v", i+1, test.sliced, result)
+		}
+	}
+
+	// Test case 2: The result does not share memory with the slice.
+	result := s.SliceRange(slice.Omit, slice.Omit, 1)
+	result.Replace(0, 10)
+	if s.Fetch(0) != 0 {
+		t.Errorf("Expected the slice to be unchanged, but got %v", s)
+	}
+
+	// Test case 3: An empty slice.
+	result = (&slice.Slice[int]{}).SliceRange(slice.Omit, slice.Omit, -1)
+	if result.Length() != 0 {
+		t.Errorf("Expected an empty slice, but got %v", result)
+	}
+}
+
 func TestSortFunc(t *testing.T) {
 	// Test case: Sort the elements of the slice using a sorting function.
 	s := &slice.Slice[int]{3, 1, 4, 1, 5, 9, 2, 6, 5, 3, 5}
@@ -1163,3 +1363,105 @@ func TestSwap(t *testing.T) {
 		t.Errorf("Expected %v, but got %v", expected, s)
 	}
 }
+
+func TestValues(t *testing.T) {
+	// Test case 1: Collect the values of the slice.
+	s := &slice.Slice[int]{1, 2, 3}
+	result := slices.Collect(s.Values())
+
+	expected := []int{1, 2, 3}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+
+	// Test case 2: Collect the values of an empty slice.
+	s = &slice.Slice[int]{}
+	if result = slices.Collect(s.Values()); len(result) != 0 {
+		t.Errorf("Expected length 0, but got %d", len(result))
+	}
+}
+
+func TestFromSeq(t *testing.T) {
+	// Test case 1: Create a slice from a value iterator.
+	s := slice.FromSeq(slices.Values([]int{1, 2, 3}))
+
+	expected := &slice.Slice[int]{1, 2, 3}
+	if !reflect.DeepEqual(s, expected) {
+		t.Errorf("Expected %v, but got %v", expected, s)
+	}
+
+	// Test case 2: Create a slice from the values of a pair iterator.
+	s = slice.FromSeq2(slices.All([]int{4, 5, 6}))
+
+	expected = &slice.Slice[int]{4, 5, 6}
+	if !reflect.DeepEqual(s, expected) {
+		t.Errorf("Expected %v, but got %v", expected, s)
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	// Test case 1: Flatten a jagged matrix with nil rows.
+	matrix := &slice.Slice[*slice.Slice[int]]{{1, 2}, nil, {3}, {}, {4, 5}}
+	result := slice.Flatten(matrix)
+
+	expected := &slice.Slice[int]{1, 2, 3, 4, 5}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+
+	// Test case 2: Flatten does not share memory with the rows.
+	result.Replace(0, 10)
+	if (*matrix)[0].Fetch(0) != 1 {
+		t.Errorf("Expected the matrix to be unchanged, but got %v", (*matrix)[0])
+	}
+}
+
+func TestInterleave(t *testing.T) {
+	testCases := []struct {
+		slices   []*slice.Slice[int]
+		expected *slice.Slice[int]
+	}{
+		// Test case 1: Slices of equal length.
+		{[]*slice.Slice[int]{{1, 2}, {10, 20}}, &slice.Slice[int]{1, 10, 2, 20}},
+		// Test case 2: Slices of different lengths and a nil slice.
+		{[]*slice.Slice[int]{{1, 2, 3}, nil, {10}, {100, 200}}, &slice.Slice[int]{1, 10, 100, 2, 200, 3}},
+		// Test case 3: No slices.
+		{nil, &slice.Slice[int]{}},
+	}
+
+	for i, test := range testCases {
+		result := slice.Interleave(test.slices...)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("Test case %d: Expected %v, but got %v", i+1, test.expected, result)
+		}
+	}
+}
+
+func TestTranspose(t *testing.T) {
+	matrix := &slice.Slice[*slice.Slice[int]]{{1, 2, 3}, nil, {4, 5}}
+	testCases := []struct {
+		matrix   *slice.Slice[*slice.Slice[int]]
+		policy   slice.FillPolicy
+		expected *slice.Slice[*slice.Slice[int]]
+	}{
+		// Test case 1: Pad the short rows with the fill value.
+		{matrix, slice.FillPad, &slice.Slice[*slice.Slice[int]]{{1, -1, 4}, {2, -1, 5}, {3, -1, -1}}},
+		// Test case 2: Skip the missing elements.
+		{matrix, slice.FillSkip, &slice.Slice[*slice.Slice[int]]{{1, 4}, {2, 5}, {3}}},
+		// Test case 3: Truncate to the shortest row.
+		{matrix, slice.FillTruncate, &slice.Slice[*slice.Slice[int]]{}},
+		// Test case 4: Truncate a matrix without empty rows.
+		{&slice.Slice[*slice.Slice[int]]{{1, 2, 3}, {4, 5}}, slice.FillTruncate, &slice.Slice[*slice.Slice[int]]{{1, 4}, {2, 5}}},
+		// Test case 5: A rectangular matrix is the same under every policy.
+		{&slice.Slice[*slice.Slice[int]]{{1, 2}, {3, 4}, {5, 6}}, slice.FillSkip, &slice.Slice[*slice.Slice[int]]{{1, 3, 5}, {2, 4, 6}}},
+		// Test case 6: An empty matrix.
+		{&slice.Slice[*slice.Slice[int]]{}, slice.FillPad, &slice.Slice[*slice.Slice[int]]{}},
+	}
+
+	for i, test := range testCases {
+		result := slice.Transpose(test.matrix, test.policy, -1)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("Test case %d: Expected %v, but got %v", i+1, test.expected, result)
+		}
+	}
+}
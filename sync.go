@@ -0,0 +1,661 @@
+package slice
+
+import (
+	"iter"
+	"slices"
+	"sync"
+)
+
+// SyncSlice represents a generic slice that is safe for concurrent use by multiple goroutines.
+// It provides the methods of Slice[T], guarded by a sync.RWMutex: read-only methods take the read lock
+// and mutating methods take the write lock. Methods that return a *Slice[T] return a private copy
+// that does not share memory with the SyncSlice. Use Do to run several steps atomically.
+//
+// Functions passed to the methods of a SyncSlice are called while the lock is held and must not call
+// methods on the same SyncSlice. The exceptions are the iterators (All, Backward, Indices and Values),
+// Pipeline and the Parallel methods, which run over a Snapshot taken when they start, so no lock is
+// held while they yield values or call fn.
+//
+// The zero value is an empty slice ready to use. A SyncSlice must not be copied after first use.
+type SyncSlice[T any] struct {
+	mutex sync.RWMutex
+	slice Slice[T]
+}
+
+// All is the concurrency-safe equivalent of Slice.All.
+// It iterates over a snapshot taken when the iteration starts.
+//
+//	newSlice := slice.NewSync[int](1, 2, 3)
+//	for i, value := range newSlice.All() {
+//	    newSlice.Append(value) // No lock is held while yielding.
+//	}
+//	fmt.Println(newSlice.Snapshot()) // &[1, 2, 3, 1, 2, 3]
+func (syncSlice *SyncSlice[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		syncSlice.Snapshot().All()(yield)
+	}
+}
+
+// Append is the concurrency-safe equivalent of Slice.Append.
+//
+//	newSlice := slice.NewSync[int]()
+//	newSlice.Append(1, 2)
+//	fmt.Println(newSlice.Snapshot()) // &[1, 2]
+func (syncSlice *SyncSlice[T]) Append(values ...T) *SyncSlice[T] {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	syncSlice.slice.Append(values...)
+	return syncSlice
+}
+
+// AppendFunc is the concurrency-safe equivalent of Slice.AppendFunc.
+func (syncSlice *SyncSlice[T]) AppendFunc(values []T, fn func(i int, value T) bool) *SyncSlice[T] {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	syncSlice.slice.AppendFunc(values, fn)
+	return syncSlice
+}
+
+// AppendLength is the concurrency-safe equivalent of Slice.AppendLength.
+func (syncSlice *SyncSlice[T]) AppendLength(values ...T) int {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	return syncSlice.slice.AppendLength(values...)
+}
+
+// At is the concurrency-safe equivalent of Slice.At.
+func (syncSlice *SyncSlice[T]) At(i int) (T, bool) {
+	syncSlice.mutex.RLock()
+	defer syncSlice.mutex.RUnlock()
+	return syncSlice.slice.At(i)
+}
+
+// Backward is the concurrency-safe equivalent of Slice.Backward.
+// It iterates over a snapshot taken when the iteration starts.
+func (syncSlice *SyncSlice[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		syncSlice.Snapshot().Backward()(yield)
+	}
+}
+
+// Bounds is the concurrency-safe equivalent of Slice.Bounds.
+func (syncSlice *SyncSlice[T]) Bounds(i int) bool {
+	syncSlice.mutex.RLock()
+	defer syncSlice.mutex.RUnlock()
+	return syncSlice.slice.Bounds(i)
+}
+
+// Clone returns a new SyncSlice containing a copy of the elements of the slice.
+func (syncSlice *SyncSlice[T]) Clone() *SyncSlice[T] {
+	return &SyncSlice[T]{slice: *syncSlice.Snapshot()}
+}
+
+// Collect is the concurrency-safe equivalent of Slice.Collect.
+// The iterator is drained before the lock is taken, so the values are appended in a single step.
+func (syncSlice *SyncSlice[T]) Collect(seq iter.Seq[T]) *SyncSlice[T] {
+	return syncSlice.Append(slices.Collect(seq)...)
+}
+
+// Concatenate is the concurrency-safe equivalent of Slice.Concatenate.
+func (syncSlice *SyncSlice[T]) Concatenate(otherSlice *Slice[T]) *SyncSlice[T] {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	syncSlice.slice.Concatenate(otherSlice)
+	return syncSlice
+}
+
+// ConcatenateAll is the concurrency-safe equivalent of Slice.ConcatenateAll.
+func (syncSlice *SyncSlice[T]) ConcatenateAll(otherSlices ...*Slice[T]) *SyncSlice[T] {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	syncSlice.slice.ConcatenateAll(otherSlices...)
+	return syncSlice
+}
+
+// ConcatenateFunc is the concurrency-safe equivalent of Slice.ConcatenateFunc.
+func (syncSlice *SyncSlice[T]) ConcatenateFunc(otherSlice *Slice[T], fn func(i int, value T) bool) *SyncSlice[T] {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	syncSlice.slice.ConcatenateFunc(otherSlice, fn)
+	return syncSlice
+}
+
+// ConcatenateLength is the concurrency-safe equivalent of Slice.ConcatenateLength.
+func (syncSlice *SyncSlice[T]) ConcatenateLength(otherSlice *Slice[T]) int {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	return syncSlice.slice.ConcatenateLength(otherSlice)
+}
+
+// Contains is the concurrency-safe equivalent of Slice.Contains.
+func (syncSlice *SyncSlice[T]) Contains(value T) bool {
+	syncSlice.mutex.RLock()
+	defer syncSlice.mutex.RUnlock()
+	return syncSlice.slice.Contains(value)
+}
+
+// ContainsMany is the concurrency-safe equivalent of Slice.ContainsMany.
+func (syncSlice *SyncSlice[T]) ContainsMany(values ...T) *Slice[bool] {
+	syncSlice.mutex.RLock()
+	defer syncSlice.mutex.RUnlock()
+	return syncSlice.slice.ContainsMany(values...)
+}
+
+// Deduplicate is the concurrency-safe equivalent of Slice.Deduplicate.
+func (syncSlice *SyncSlice[T]) Deduplicate() *SyncSlice[T] {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	syncSlice.slice.Deduplicate()
+	return syncSlice
+}
+
+// Delete is the concurrency-safe equivalent of Slice.Delete.
+func (syncSlice *SyncSlice[T]) Delete(i int) *SyncSlice[T] {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	syncSlice.slice.Delete(i)
+	return syncSlice
+}
+
+// DeleteFunc is the concurrency-safe equivalent of Slice.DeleteFunc.
+func (syncSlice *SyncSlice[T]) DeleteFunc(fn func(i int, value T) bool) *SyncSlice[T] {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	syncSlice.slice.DeleteFunc(fn)
+	return syncSlice
+}
+
+// DeleteLength is the concurrency-safe equivalent of Slice.DeleteLength.
+func (syncSlice *SyncSlice[T]) DeleteLength(i int) int {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	return syncSlice.slice.DeleteLength(i)
+}
+
+// DeleteOK is the concurrency-safe equivalent of Slice.DeleteOK.
+func (syncSlice *SyncSlice[T]) DeleteOK(i int) bool {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	return syncSlice.slice.DeleteOK(i)
+}
+
+// DeleteRange is the concurrency-safe equivalent of Slice.DeleteRange.
+func (syncSlice *SyncSlice[T]) DeleteRange(start int, stop int, step int) *SyncSlice[T] {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	syncSlice.slice.DeleteRange(start, stop, step)
+	return syncSlice
+}
+
+// DeleteUnsafe is the concurrency-safe equivalent of Slice.DeleteUnsafe. It panics if the index is out of bounds.
+func (syncSlice *SyncSlice[T]) DeleteUnsafe(i int) *SyncSlice[T] {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	syncSlice.slice.DeleteUnsafe(i)
+	return syncSlice
+}
+
+// Do calls the given function with the underlying slice while holding the write lock and returns the SyncSlice.
+// It allows several steps to run atomically. The function must not retain the slice after it returns.
+//
+//	queue := slice.NewSync[int](1, 2, 3)
+//	queue.Do(func(s *slice.Slice[int]) {
+//	    if s.Length() > 2 {
+//	        s.Poll()
+//	    }
+//	})
+//	fmt.Println(queue.Snapshot()) // &[2, 3]
+func (syncSlice *SyncSlice[T]) Do(fn func(slice *Slice[T])) *SyncSlice[T] {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	fn(&syncSlice.slice)
+	return syncSlice
+}
+
+// Each is the concurrency-safe equivalent of Slice.Each.
+func (syncSlice *SyncSlice[T]) Each(fn func(i int, value T)) *SyncSlice[T] {
+	syncSlice.mutex.RLock()
+	defer syncSlice.mutex.RUnlock()
+	syncSlice.slice.Each(fn)
+	return syncSlice
+}
+
+// EachBreak is the concurrency-safe equivalent of Slice.EachBreak.
+func (syncSlice *SyncSlice[T]) EachBreak(fn func(i int, value T) bool) *SyncSlice[T] {
+	syncSlice.mutex.RLock()
+	defer syncSlice.mutex.RUnlock()
+	syncSlice.slice.EachBreak(fn)
+	return syncSlice
+}
+
+// EachOK is the concurrency-safe equivalent of Slice.EachOK.
+func (syncSlice *SyncSlice[T]) EachOK(fn func(i int, value T) bool) bool {
+	syncSlice.mutex.RLock()
+	defer syncSlice.mutex.RUnlock()
+	return syncSlice.slice.EachOK(fn)
+}
+
+// EachReverse is the concurrency-safe equivalent of Slice.EachReverse.
+func (syncSlice *SyncSlice[T]) EachReverse(fn func(i int, value T)) *SyncSlice[T] {
+	syncSlice.mutex.RLock()
+	defer syncSlice.mutex.RUnlock()
+	syncSlice.slice.EachReverse(fn)
+	return syncSlice
+}
+
+// EachReverseBreak is the concurrency-safe equivalent of Slice.EachReverseBreak.
+func (syncSlice *SyncSlice[T]) EachReverseBreak(fn func(i int, value T) bool) *SyncSlice[T] {
+	syncSlice.mutex.RLock()
+	defer syncSlice.mutex.RUnlock()
+	syncSlice.slice.EachReverseBreak(fn)
+	return syncSlice
+}
+
+// EachReverseOK is the concurrency-safe equivalent of Slice.EachReverseOK.
+func (syncSlice *SyncSlice[T]) EachReverseOK(fn func(i int, value T) bool) bool {
+	syncSlice.mutex.RLock()
+	defer syncSlice.mutex.RUnlock()
+	return syncSlice.slice.EachReverseOK(fn)
+}
+
+// Equal is the concurrency-safe equivalent of Slice.Equal.
+func (syncSlice *SyncSlice[T]) Equal(otherSlice *Slice[T]) bool {
+	syncSlice.mutex.RLock()
+	defer syncSlice.mutex.RUnlock()
+	return syncSlice.slice.Equal(otherSlice)
+}
+
+// EqualFunc is the concurrency-safe equivalent of Slice.EqualFunc.
+func (syncSlice *SyncSlice[T]) EqualFunc(otherSlice *Slice[T], fn func(i int, a T, b T) bool) bool {
+	syncSlice.mutex.RLock()
+	defer syncSlice.mutex.RUnlock()
+	return syncSlice.slice.EqualFunc(otherSlice, fn)
+}
+
+// EqualLength is the concurrency-safe equivalent of Slice.EqualLength.
+func (syncSlice *SyncSlice[T]) EqualLength(otherSlice *Slice[T]) bool {
+	syncSlice.mutex.RLock()
+	defer syncSlice.mutex.RUnlock()
+	return syncSlice.slice.EqualLength(otherSlice)
+}
+
+// Fetch is the concurrency-safe equivalent of Slice.Fetch.
+func (syncSlice *SyncSlice[T]) Fetch(i int) T {
+	syncSlice.mutex.RLock()
+	defer syncSlice.mutex.RUnlock()
+	return syncSlice.slice.Fetch(i)
+}
+
+// FetchLength is the concurrency-safe equivalent of Slice.FetchLength.
+func (syncSlice *SyncSlice[T]) FetchLength(i int) (T, int) {
+	syncSlice.mutex.RLock()
+	defer syncSlice.mutex.RUnlock()
+	return syncSlice.slice.FetchLength(i)
+}
+
+// Filter is the concurrency-safe equivalent of Slice.Filter.
+func (syncSlice *SyncSlice[T]) Filter(fn func(i int, value T) bool) *Slice[T] {
+	syncSlice.mutex.RLock()
+	defer syncSlice.mutex.RUnlock()
+	return syncSlice.slice.Filter(fn)
+}
+
+// FindIndex is the concurrency-safe equivalent of Slice.FindIndex.
+func (syncSlice *SyncSlice[T]) FindIndex(fn func(value T) bool) (int, bool) {
+	syncSlice.mutex.RLock()
+	defer syncSlice.mutex.RUnlock()
+	return syncSlice.slice.FindIndex(fn)
+}
+
+// Get is the concurrency-safe equivalent of Slice.Get.
+func (syncSlice *SyncSlice[T]) Get(i int) (T, bool) {
+	syncSlice.mutex.RLock()
+	defer syncSlice.mutex.RUnlock()
+	return syncSlice.slice.Get(i)
+}
+
+// GetLength is the concurrency-safe equivalent of Slice.GetLength.
+func (syncSlice *SyncSlice[T]) GetLength(i int) (T, bool, int) {
+	syncSlice.mutex.RLock()
+	defer syncSlice.mutex.RUnlock()
+	return syncSlice.slice.GetLength(i)
+}
+
+// Indices is the concurrency-safe equivalent of Slice.Indices.
+// It yields the indices of the slice at the time the iteration starts.
+func (syncSlice *SyncSlice[T]) Indices() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := range syncSlice.Length() {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+// IsEmpty is the concurrency-safe equivalent of Slice.IsEmpty.
+func (syncSlice *SyncSlice[T]) IsEmpty() bool {
+	syncSlice.mutex.RLock()
+	defer syncSlice.mutex.RUnlock()
+	return syncSlice.slice.IsEmpty()
+}
+
+// IsPopulated is the concurrency-safe equivalent of Slice.IsPopulated.
+func (syncSlice *SyncSlice[T]) IsPopulated() bool {
+	syncSlice.mutex.RLock()
+	defer syncSlice.mutex.RUnlock()
+	return syncSlice.slice.IsPopulated()
+}
+
+// Length is the concurrency-safe equivalent of Slice.Length.
+func (syncSlice *SyncSlice[T]) Length() int {
+	syncSlice.mutex.RLock()
+	defer syncSlice.mutex.RUnlock()
+	return syncSlice.slice.Length()
+}
+
+// Make is the concurrency-safe equivalent of Slice.Make.
+func (syncSlice *SyncSlice[T]) Make(i int) *SyncSlice[T] {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	syncSlice.slice.Make(i)
+	return syncSlice
+}
+
+// MakeEach is the concurrency-safe equivalent of Slice.MakeEach.
+func (syncSlice *SyncSlice[T]) MakeEach(values ...T) *SyncSlice[T] {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	syncSlice.slice.MakeEach(values...)
+	return syncSlice
+}
+
+// MakeEachReverse is the concurrency-safe equivalent of Slice.MakeEachReverse.
+func (syncSlice *SyncSlice[T]) MakeEachReverse(values ...T) *SyncSlice[T] {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	syncSlice.slice.MakeEachReverse(values...)
+	return syncSlice
+}
+
+// Map is the concurrency-safe equivalent of Slice.Map.
+func (syncSlice *SyncSlice[T]) Map(fn func(i int, value T) T) *Slice[T] {
+	syncSlice.mutex.RLock()
+	defer syncSlice.mutex.RUnlock()
+	return syncSlice.slice.Map(fn)
+}
+
+// MapReverse is the concurrency-safe equivalent of Slice.MapReverse.
+func (syncSlice *SyncSlice[T]) MapReverse(fn func(i int, value T) T) *Slice[T] {
+	syncSlice.mutex.RLock()
+	defer syncSlice.mutex.RUnlock()
+	return syncSlice.slice.MapReverse(fn)
+}
+
+// Modify is the concurrency-safe equivalent of Slice.Modify.
+func (syncSlice *SyncSlice[T]) Modify(fn func(i int, value T) T) *SyncSlice[T] {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	syncSlice.slice.Modify(fn)
+	return syncSlice
+}
+
+// ModifyReverse is the concurrency-safe equivalent of Slice.ModifyReverse.
+func (syncSlice *SyncSlice[T]) ModifyReverse(fn func(i int, value T) T) *SyncSlice[T] {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	syncSlice.slice.ModifyReverse(fn)
+	return syncSlice
+}
+
+// ParallelEach is the concurrency-safe equivalent of Slice.ParallelEach. It runs over a snapshot.
+func (syncSlice *SyncSlice[T]) ParallelEach(options ParallelOptions, fn func(i int, value T)) error {
+	return syncSlice.Snapshot().ParallelEach(options, fn)
+}
+
+// ParallelFilter is the concurrency-safe equivalent of Slice.ParallelFilter. It runs over a snapshot.
+func (syncSlice *SyncSlice[T]) ParallelFilter(options ParallelOptions, fn func(i int, value T) bool) (*Slice[T], error) {
+	return syncSlice.Snapshot().ParallelFilter(options, fn)
+}
+
+// ParallelMap is the concurrency-safe equivalent of Slice.ParallelMap. It runs over a snapshot.
+func (syncSlice *SyncSlice[T]) ParallelMap(options ParallelOptions, fn func(i int, value T) T) (*Slice[T], error) {
+	return syncSlice.Snapshot().ParallelMap(options, fn)
+}
+
+// ParallelReduce is the concurrency-safe equivalent of Slice.ParallelReduce. It runs over a snapshot.
+func (syncSlice *SyncSlice[T]) ParallelReduce(options ParallelOptions, fn func(i int, currentValue T, resultValue T) T, combine func(a T, b T) T) (T, error) {
+	return syncSlice.Snapshot().ParallelReduce(options, fn, combine)
+}
+
+// Pipeline is the concurrency-safe equivalent of Slice.Pipeline. The pipeline reads a snapshot,
+// so later writes to the SyncSlice are not visible to it.
+func (syncSlice *SyncSlice[T]) Pipeline() *Pipeline[T] {
+	return syncSlice.Snapshot().Pipeline()
+}
+
+// Poll is the concurrency-safe equivalent of Slice.Poll.
+func (syncSlice *SyncSlice[T]) Poll() T {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	return syncSlice.slice.Poll()
+}
+
+// PollLength is the concurrency-safe equivalent of Slice.PollLength.
+func (syncSlice *SyncSlice[T]) PollLength() (T, int) {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	return syncSlice.slice.PollLength()
+}
+
+// PollOK is the concurrency-safe equivalent of Slice.PollOK.
+func (syncSlice *SyncSlice[T]) PollOK() (T, bool) {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	return syncSlice.slice.PollOK()
+}
+
+// Pop is the concurrency-safe equivalent of Slice.Pop.
+func (syncSlice *SyncSlice[T]) Pop() T {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	return syncSlice.slice.Pop()
+}
+
+// PopLength is the concurrency-safe equivalent of Slice.PopLength.
+func (syncSlice *SyncSlice[T]) PopLength() (T, int) {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	return syncSlice.slice.PopLength()
+}
+
+// PopOK is the concurrency-safe equivalent of Slice.PopOK.
+func (syncSlice *SyncSlice[T]) PopOK() (T, bool) {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	return syncSlice.slice.PopOK()
+}
+
+// Precatenate is the concurrency-safe equivalent of Slice.Precatenate.
+func (syncSlice *SyncSlice[T]) Precatenate(otherSlice *Slice[T]) *SyncSlice[T] {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	syncSlice.slice.Precatenate(otherSlice)
+	return syncSlice
+}
+
+// PrecatenateFunc is the concurrency-safe equivalent of Slice.PrecatenateFunc.
+func (syncSlice *SyncSlice[T]) PrecatenateFunc(otherSlice *Slice[T], fn func(i int, value T) bool) *SyncSlice[T] {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	syncSlice.slice.PrecatenateFunc(otherSlice, fn)
+	return syncSlice
+}
+
+// PrecatenateLength is the concurrency-safe equivalent of Slice.PrecatenateLength.
+func (syncSlice *SyncSlice[T]) PrecatenateLength(otherSlice *Slice[T]) int {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	return syncSlice.slice.PrecatenateLength(otherSlice)
+}
+
+// Prepend is the concurrency-safe equivalent of Slice.Prepend.
+func (syncSlice *SyncSlice[T]) Prepend(values ...T) *SyncSlice[T] {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	syncSlice.slice.Prepend(values...)
+	return syncSlice
+}
+
+// PrependFunc is the concurrency-safe equivalent of Slice.PrependFunc.
+func (syncSlice *SyncSlice[T]) PrependFunc(values []T, fn func(i int, value T) bool) *SyncSlice[T] {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	syncSlice.slice.PrependFunc(values, fn)
+	return syncSlice
+}
+
+// PrependLength is the concurrency-safe equivalent of Slice.PrependLength.
+func (syncSlice *SyncSlice[T]) PrependLength(values ...T) int {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	return syncSlice.slice.PrependLength(values...)
+}
+
+// Reduce is the concurrency-safe equivalent of Slice.Reduce.
+func (syncSlice *SyncSlice[T]) Reduce(fn func(i int, currentValue T, resultValue T) T) T {
+	syncSlice.mutex.RLock()
+	defer syncSlice.mutex.RUnlock()
+	return syncSlice.slice.Reduce(fn)
+}
+
+// ReduceReverse is the concurrency-safe equivalent of Slice.ReduceReverse.
+func (syncSlice *SyncSlice[T]) ReduceReverse(fn func(i int, currentValue T, resultValue T) T) T {
+	syncSlice.mutex.RLock()
+	defer syncSlice.mutex.RUnlock()
+	return syncSlice.slice.ReduceReverse(fn)
+}
+
+// Replace is the concurrency-safe equivalent of Slice.Replace.
+func (syncSlice *SyncSlice[T]) Replace(i int, value T) bool {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	return syncSlice.slice.Replace(i, value)
+}
+
+// Reverse is the concurrency-safe equivalent of Slice.Reverse.
+func (syncSlice *SyncSlice[T]) Reverse() *SyncSlice[T] {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	syncSlice.slice.Reverse()
+	return syncSlice
+}
+
+// Shuffle is the concurrency-safe equivalent of Slice.Shuffle.
+func (syncSlice *SyncSlice[T]) Shuffle() *SyncSlice[T] {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	syncSlice.slice.Shuffle()
+	return syncSlice
+}
+
+// Slice is the concurrency-safe equivalent of Slice.Slice. The returned slice is a copy.
+func (syncSlice *SyncSlice[T]) Slice(i int, j int) *Slice[T] {
+	syncSlice.mutex.RLock()
+	defer syncSlice.mutex.RUnlock()
+	newSlice := slices.Clone(*syncSlice.slice.Slice(i, j))
+	return &newSlice
+}
+
+// Snapshot returns a copy of the elements of the slice that does not share memory with the SyncSlice.
+//
+//	newSlice := slice.NewSync[int](1, 2, 3)
+//	snapshot := newSlice.Snapshot()
+//	newSlice.Append(4)
+//	fmt.Println(snapshot) // &[1, 2, 3]
+func (syncSlice *SyncSlice[T]) Snapshot() *Slice[T] {
+	syncSlice.mutex.RLock()
+	defer syncSlice.mutex.RUnlock()
+	newSlice := slices.Clone(syncSlice.slice)
+	if newSlice == nil {
+		newSlice = Slice[T]{}
+	}
+	return &newSlice
+}
+
+// SliceRange is the concurrency-safe equivalent of Slice.SliceRange. The returned slice is a copy.
+func (syncSlice *SyncSlice[T]) SliceRange(start int, stop int, step int) *Slice[T] {
+	syncSlice.mutex.RLock()
+	defer syncSlice.mutex.RUnlock()
+	return syncSlice.slice.SliceRange(start, stop, step)
+}
+
+// SlidingReduce is the concurrency-safe equivalent of Slice.SlidingReduce.
+func (syncSlice *SyncSlice[T]) SlidingReduce(size int, fn func(i int, currentValue T, resultValue T) T) *Slice[T] {
+	syncSlice.mutex.RLock()
+	defer syncSlice.mutex.RUnlock()
+	return syncSlice.slice.SlidingReduce(size, fn)
+}
+
+// SortFunc is the concurrency-safe equivalent of Slice.SortFunc.
+func (syncSlice *SyncSlice[T]) SortFunc(fn func(i int, j int, a T, b T) bool) *SyncSlice[T] {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	syncSlice.slice.SortFunc(fn)
+	return syncSlice
+}
+
+// Splice is the concurrency-safe equivalent of Slice.Splice.
+func (syncSlice *SyncSlice[T]) Splice(i int, j int) *SyncSlice[T] {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	syncSlice.slice.Splice(i, j)
+	return syncSlice
+}
+
+// Split is the concurrency-safe equivalent of Slice.Split. The returned slices are copies.
+func (syncSlice *SyncSlice[T]) Split(i int) (*Slice[T], *Slice[T]) {
+	firstSlice, secondSlice, _ := syncSlice.SplitOK(i)
+	return firstSlice, secondSlice
+}
+
+// SplitFunc is the concurrency-safe equivalent of Slice.SplitFunc.
+func (syncSlice *SyncSlice[T]) SplitFunc(fn func(i int, value T) bool) (*Slice[T], *Slice[T]) {
+	syncSlice.mutex.RLock()
+	defer syncSlice.mutex.RUnlock()
+	return syncSlice.slice.SplitFunc(fn)
+}
+
+// SplitOK is the concurrency-safe equivalent of Slice.SplitOK. The returned slices are copies.
+func (syncSlice *SyncSlice[T]) SplitOK(i int) (*Slice[T], *Slice[T], bool) {
+	syncSlice.mutex.RLock()
+	defer syncSlice.mutex.RUnlock()
+	firstSlice, secondSlice, ok := syncSlice.slice.SplitOK(i)
+	if !ok {
+		return nil, nil, false
+	}
+	firstCopy, secondCopy := slices.Clone(*firstSlice), slices.Clone(*secondSlice)
+	return &firstCopy, &secondCopy, true
+}
+
+// Swap is the concurrency-safe equivalent of Slice.Swap.
+func (syncSlice *SyncSlice[T]) Swap(i int, j int) {
+	syncSlice.mutex.Lock()
+	defer syncSlice.mutex.Unlock()
+	syncSlice.slice.Swap(i, j)
+}
+
+// Values is the concurrency-safe equivalent of Slice.Values.
+// It iterates over a snapshot taken when the iteration starts.
+func (syncSlice *SyncSlice[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		syncSlice.Snapshot().Values()(yield)
+	}
+}
+
+// NewSync creates a new instance of the SyncSlice[T] type and initializes it with the provided values.
+func NewSync[T any](values ...T) *SyncSlice[T] {
+	syncSlice := &SyncSlice[T]{}
+	syncSlice.slice.Append(values...)
+	return syncSlice
+}
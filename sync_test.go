@@ -0,0 +1,278 @@
+package slice_test
+
+import (
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/lindsaygelle/slice"
+)
+
+func TestSyncAppend(t *testing.T) {
+	// Test case 1: Append values and read them back.
+	s := slice.NewSync[int](1)
+	s.Append(2, 3).Prepend(0)
+
+	expected := &slice.Slice[int]{0, 1, 2, 3}
+	if result := s.Snapshot(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+
+	// Test case 2: Append concurrently from many goroutines.
+	s = &slice.SyncSlice[int]{}
+	var waitGroup sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			for j := 0; j < 250; j++ {
+				s.Append(j)
+			}
+		}()
+	}
+	waitGroup.Wait()
+	if length := s.Length(); length != 4000 {
+		t.Errorf("Expected length 4000, but got %d", length)
+	}
+}
+
+func TestSyncDo(t *testing.T) {
+	// Test case: Run several steps atomically from many goroutines.
+	s := slice.NewSync[int](0)
+	var waitGroup sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			for j := 0; j < 100; j++ {
+				s.Do(func(s *slice.Slice[int]) {
+					s.Append(s.Pop() + 1)
+				})
+			}
+		}()
+	}
+	waitGroup.Wait()
+
+	expected := &slice.Slice[int]{1600}
+	if result := s.Snapshot(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+}
+
+func TestSyncPollPop(t *testing.T) {
+	// Test case: Drain a shared queue from several consumers without losing or duplicating values.
+	s := slice.NewSync[int]()
+	for i := 0; i < 4000; i++ {
+		s.Append(i)
+	}
+	seen := make([]int, 4000)
+	var mutex sync.Mutex
+	var waitGroup sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		waitGroup.Add(1)
+		go func(i int) {
+			defer waitGroup.Done()
+			for {
+				var value int
+				var ok bool
+				if i%2 == 0 {
+					value, ok = s.PollOK()
+				} else {
+					value, ok = s.PopOK()
+				}
+				if !ok {
+					return
+				}
+				mutex.Lock()
+				seen[value]++
+				mutex.Unlock()
+			}
+		}(i)
+	}
+	waitGroup.Wait()
+	for value, count := range seen {
+		if count != 1 {
+			t.Errorf("Expected value %d to be seen once, but got %d", value, count)
+		}
+	}
+	if !s.IsEmpty() {
+		t.Errorf("Expected true, but got false")
+	}
+}
+
+func TestSyncReadersAndWriters(t *testing.T) {
+	// Test case: Mix readers and writers under the race detector.
+	s := slice.NewSync[int](1, 2, 3)
+	var waitGroup sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		waitGroup.Add(2)
+		go func() {
+			defer waitGroup.Done()
+			for j := 0; j < 200; j++ {
+				s.Append(j)
+				s.Modify(func(i int, value int) int {
+					return value + 1
+				})
+				s.DeleteOK(0)
+			}
+		}()
+		go func() {
+			defer waitGroup.Done()
+			for j := 0; j < 200; j++ {
+				_ = s.Contains(j)
+				_ = s.Filter(func(i int, value int) bool {
+					return value%2 == 0
+				})
+				_, _ = s.Get(j)
+				_ = s.Snapshot()
+			}
+		}()
+	}
+	waitGroup.Wait()
+	if length := s.Length(); length != 3 {
+		t.Errorf("Expected length 3, but got %d", length)
+	}
+}
+
+func TestSyncSnapshot(t *testing.T) {
+	// Test case 1: A snapshot does not change when the slice changes.
+	s := slice.NewSync[int](1, 2, 3)
+	snapshot := s.Snapshot()
+	s.Replace(0, 10)
+	s.Append(4)
+
+	expected := &slice.Slice[int]{1, 2, 3}
+	if !reflect.DeepEqual(snapshot, expected) {
+		t.Errorf("Expected %v, but got %v", expected, snapshot)
+	}
+
+	// Test case 2: Slices returned by read methods are private copies.
+	part := s.Slice(0, 1)
+	part.Replace(0, 99)
+	if value := s.Fetch(0); value != 10 {
+		t.Errorf("Expected 10, but got %d", value)
+	}
+}
+
+func TestSyncIterators(t *testing.T) {
+	// Test case 1: Iterators yield a snapshot, so the loop body may write to the slice.
+	s := slice.NewSync[int](1, 2, 3)
+	for _, value := range s.All() {
+		s.Append(value * 10)
+	}
+
+	expected := &slice.Slice[int]{1, 2, 3, 10, 20, 30}
+	if result := s.Snapshot(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+
+	// Test case 2: Every iterator matches its Slice equivalent.
+	values := s.Snapshot()
+	if result, expected := slices.Collect(s.Values()), slices.Collect(values.Values()); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+	if result, expected := slices.Collect(s.Indices()), slices.Collect(values.Indices()); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+	var backward []int
+	for i, value := range s.Backward() {
+		backward = append(backward, i, value)
+	}
+	if expected := []int{5, 30, 4, 20, 3, 10, 2, 3, 1, 2, 0, 1}; !reflect.DeepEqual(backward, expected) {
+		t.Errorf("Expected %v, but got %v", expected, backward)
+	}
+
+	// Test case 3: Breaking out of the loop stops the iteration.
+	count := 0
+	for range s.Values() {
+		count++
+		break
+	}
+	if count != 1 {
+		t.Errorf("Expected 1 iteration, but got %d", count)
+	}
+}
+
+func TestSyncPipelineAndParallel(t *testing.T) {
+	s := slice.NewSync[int](1, 2, 3, 4, 5)
+
+	// Test case 1: Pipeline runs over a snapshot.
+	pipeline := s.Pipeline().Filter(func(value int) bool {
+		return value%2 == 1
+	})
+	s.Append(7)
+	if result := pipeline.Collect(); !reflect.DeepEqual(result, &slice.Slice[int]{1, 3, 5}) {
+		t.Errorf("Expected %v, but got %v", &slice.Slice[int]{1, 3, 5}, result)
+	}
+
+	// Test case 2: Parallel functions run without the lock, so they may call methods on the slice.
+	err := s.ParallelEach(slice.ParallelOptions{Workers: 4}, func(i int, value int) {
+		s.Bounds(i)
+	})
+	if err != nil {
+		t.Errorf("Expected no error, but got %v", err)
+	}
+	mapped, err := s.ParallelMap(slice.ParallelOptions{}, func(i int, value int) int {
+		return value * s.Length()
+	})
+	if expected := (&slice.Slice[int]{6, 12, 18, 24, 30, 42}); err != nil || !reflect.DeepEqual(mapped, expected) {
+		t.Errorf("Expected %v, but got %v, %v", expected, mapped, err)
+	}
+	filtered, err := s.ParallelFilter(slice.ParallelOptions{}, func(i int, value int) bool {
+		return value > 4
+	})
+	if expected := (&slice.Slice[int]{5, 7}); err != nil || !reflect.DeepEqual(filtered, expected) {
+		t.Errorf("Expected %v, but got %v, %v", expected, filtered, err)
+	}
+	sum, err := s.ParallelReduce(slice.ParallelOptions{}, func(i int, currentValue int, resultValue int) int {
+		return currentValue + resultValue
+	}, func(a int, b int) int {
+		return a + b
+	})
+	if err != nil || sum != 22 {
+		t.Errorf("Expected 22, but got %d, %v", sum, err)
+	}
+}
+
+func TestSyncSlidingReduce(t *testing.T) {
+	s := slice.NewSync[int](1, 3, 2, 5)
+	result := s.SlidingReduce(2, func(i int, currentValue int, resultValue int) int {
+		return max(currentValue, resultValue)
+	})
+
+	expected := &slice.Slice[int]{3, 3, 5}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+}
+
+func TestSyncConcatenateAll(t *testing.T) {
+	s := slice.NewSync[int](1)
+	s.ConcatenateAll(&slice.Slice[int]{2, 3}, nil, &slice.Slice[int]{4})
+
+	expected := &slice.Slice[int]{1, 2, 3, 4}
+	if result := s.Snapshot(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+}
+
+func TestSyncRange(t *testing.T) {
+	s := slice.NewSync[int](0, 1, 2, 3, 4)
+
+	// Test case 1: Negative indices count from the end.
+	if value, ok := s.At(-1); !ok || value != 4 {
+		t.Errorf("Expected 4, true but got %d, %v", value, ok)
+	}
+
+	// Test case 2: SliceRange returns a stepped copy.
+	if result := s.SliceRange(slice.Omit, slice.Omit, -2); !reflect.DeepEqual(result, &slice.Slice[int]{4, 2, 0}) {
+		t.Errorf("Expected %v, but got %v", &slice.Slice[int]{4, 2, 0}, result)
+	}
+
+	// Test case 3: DeleteRange removes the same elements.
+	s.DeleteRange(slice.Omit, slice.Omit, 2)
+	if result := s.Snapshot(); !reflect.DeepEqual(result, &slice.Slice[int]{1, 3}) {
+		t.Errorf("Expected %v, but got %v", &slice.Slice[int]{1, 3}, result)
+	}
+}
@@ -0,0 +1,778 @@
+package slice
+
+import (
+	"iter"
+	"slices"
+)
+
+const (
+	vectorBits  = 5
+	vectorWidth = 1 << vectorBits
+	vectorExtra = 2 // The number of nodes a concatenation may leave beyond the fewest that could hold the values.
+)
+
+// Vector represents a generic immutable sequence implemented as a persistent relaxed radix balanced trie:
+// a 32-way trie whose branches may hold less than a full subtree, so that two tries can be joined cheaply.
+// Methods that change a Vector return a new Vector and leave the receiver untouched; the
+// versions share every node that did not change, so keeping old versions around is cheap.
+//
+// Get, Set, Append, Delete and Slice run in O(log32 n) time, which is effectively constant.
+// Slice splits the trie along the paths to its ends, and Delete joins the values before and after the
+// deleted one. Use a VectorBuilder to apply many changes in a batch.
+//
+// The zero value is an empty Vector ready to use.
+type Vector[T any] struct {
+	data vectorData[T]
+}
+
+// Append returns a new Vector with the given values added to the end.
+//
+//	vector1 := slice.NewVector[int](1, 2)
+//	vector2 := vector1.Append(3)
+//	fmt.Println(vector1.ToSlice(), vector2.ToSlice()) // &[1, 2], &[1, 2, 3]
+func (vector *Vector[T]) Append(values ...T) *Vector[T] {
+	if len(values) == 1 {
+		newVector := &Vector[T]{data: vector.data}
+		newVector.data.append(nil, values[0])
+		return newVector
+	}
+	return vector.Builder().Append(values...).Vector()
+}
+
+// Builder returns a VectorBuilder initialized with the values of the Vector.
+// Changes made through the builder do not affect the receiver.
+//
+//	vector := slice.NewVector[int](1, 2, 3)
+//	builder := vector.Builder()
+//	builder.Set(0, 10)
+//	fmt.Println(vector.ToSlice(), builder.Vector().ToSlice()) // &[1, 2, 3], &[10, 2, 3]
+func (vector *Vector[T]) Builder() *VectorBuilder[T] {
+	data := vector.data
+	data.tailEdit = nil
+	return &VectorBuilder[T]{data: data, edit: &vectorEdit{}}
+}
+
+// Delete returns a new Vector without the value at the specified index, or the receiver if the index is out of bounds.
+//
+//	vector1 := slice.NewVector[int](1, 2, 3)
+//	vector2 := vector1.Delete(1)
+//	fmt.Println(vector1.ToSlice(), vector2.ToSlice()) // &[1, 2, 3], &[1, 3]
+func (vector *Vector[T]) Delete(i int) *Vector[T] {
+	if !vector.Bounds(i) {
+		return vector
+	}
+	newVector := &Vector[T]{data: vector.data}
+	newVector.data.delete(nil, i)
+	return newVector
+}
+
+// Bounds checks if the given index is within the bounds of the Vector.
+func (vector *Vector[T]) Bounds(i int) bool {
+	return i >= 0 && i < vector.data.count
+}
+
+// Each applies the given function to each value of the Vector in order and returns the Vector.
+//
+//	vector := slice.NewVector[int](1, 2, 3)
+//	vector.Each(func(i int, value int) {
+//	    fmt.Println(value)
+//	})
+//	// Output:
+//	// 1
+//	// 2
+//	// 3
+func (vector *Vector[T]) Each(fn func(i int, value T)) *Vector[T] {
+	for i, value := range vector.All() {
+		fn(i, value)
+	}
+	return vector
+}
+
+// All returns an iterator over the index-value pairs of the Vector in order.
+//
+//	vector := slice.NewVector[int](1, 2, 3)
+//	for i, value := range vector.All() {
+//	    fmt.Println(i, value)
+//	}
+func (vector *Vector[T]) All() iter.Seq2[int, T] {
+	return vector.data.all()
+}
+
+// Fetch returns the value at the specified index, or a zero value if the index is out of bounds.
+//
+//	vector := slice.NewVector[int](1, 2, 3)
+//	value := vector.Fetch(1)
+//	fmt.Println(value) // 2
+func (vector *Vector[T]) Fetch(i int) T {
+	value, _ := vector.Get(i)
+	return value
+}
+
+// Get returns the value at the specified index and true, or a zero value and false if the index is out of bounds.
+//
+//	vector := slice.NewVector[int](1, 2, 3)
+//	value, ok := vector.Get(1)
+//	fmt.Println(value, ok) // 2, true
+func (vector *Vector[T]) Get(i int) (T, bool) {
+	var value T
+	if !vector.Bounds(i) {
+		return value, false
+	}
+	return vector.data.get(i), true
+}
+
+// IsEmpty returns true if the Vector is empty, or false otherwise.
+func (vector *Vector[T]) IsEmpty() bool {
+	return vector.data.count == 0
+}
+
+// Length returns the number of values in the Vector.
+func (vector *Vector[T]) Length() int {
+	return vector.data.count
+}
+
+// Set returns a new Vector with the value at the specified index replaced, or the receiver if the index is out of bounds.
+//
+//	vector1 := slice.NewVector[int](1, 2, 3)
+//	vector2 := vector1.Set(1, 10)
+//	fmt.Println(vector1.ToSlice(), vector2.ToSlice()) // &[1, 2, 3], &[1, 10, 3]
+func (vector *Vector[T]) Set(i int, value T) *Vector[T] {
+	if !vector.Bounds(i) {
+		return vector
+	}
+	newVector := &Vector[T]{data: vector.data}
+	newVector.data.set(nil, i, value)
+	return newVector
+}
+
+// Slice returns a new Vector containing the values from index i to j (inclusive), matching Slice.Slice.
+// If j is less than i the arguments are swapped, and indices outside the Vector are clamped to its bounds.
+//
+//	vector := slice.NewVector[int](1, 2, 3, 4, 5)
+//	subVector := vector.Slice(1, 3)
+//	fmt.Println(subVector.ToSlice()) // &[2, 3, 4]
+func (vector *Vector[T]) Slice(i int, j int) *Vector[T] {
+	if j < i {
+		i, j = j, i
+	}
+	i, j = max(i, 0), min(j, vector.data.count-1)
+	if i > j {
+		return &Vector[T]{}
+	}
+	newVector := &Vector[T]{data: vector.data}
+	newVector.data.take(nil, j+1)
+	newVector.data.drop(nil, i)
+	return newVector
+}
+
+// ToSlice returns a new slice containing the values of the Vector.
+//
+//	vector := slice.NewVector[int](1, 2, 3)
+//	fmt.Println(vector.ToSlice()) // &[1, 2, 3]
+func (vector *Vector[T]) ToSlice() *Slice[T] {
+	newSlice := make(Slice[T], 0, vector.data.count)
+	for _, value := range vector.All() {
+		newSlice = append(newSlice, value)
+	}
+	return &newSlice
+}
+
+// VectorBuilder represents a transient, mutable view of a Vector used to apply many changes in a batch.
+// Nodes created by the builder are changed in place until Vector is called, so a batch of changes
+// costs far less than applying them one at a time to a persistent Vector.
+//
+// A VectorBuilder is not safe for concurrent use. The zero value is an empty builder ready to use.
+type VectorBuilder[T any] struct {
+	data vectorData[T]
+	edit *vectorEdit
+}
+
+// Append adds the given values to the end of the builder and returns the builder.
+//
+//	builder := &slice.VectorBuilder[int]{}
+//	builder.Append(1, 2, 3)
+//	fmt.Println(builder.Vector().ToSlice()) // &[1, 2, 3]
+func (builder *VectorBuilder[T]) Append(values ...T) *VectorBuilder[T] {
+	edit := builder.ensureEdit()
+	for _, value := range values {
+		builder.data.append(edit, value)
+	}
+	return builder
+}
+
+// Delete removes the value at the specified index and returns true if the index is valid, or false otherwise.
+//
+//	builder := slice.NewVector[int](1, 2, 3).Builder()
+//	ok := builder.Delete(0)
+//	fmt.Println(builder.Vector().ToSlice(), ok) // &[2, 3], true
+func (builder *VectorBuilder[T]) Delete(i int) bool {
+	if i < 0 || i >= builder.data.count {
+		return false
+	}
+	builder.data.delete(builder.ensureEdit(), i)
+	return true
+}
+
+// Get returns the value at the specified index and true, or a zero value and false if the index is out of bounds.
+func (builder *VectorBuilder[T]) Get(i int) (T, bool) {
+	var value T
+	if i < 0 || i >= builder.data.count {
+		return value, false
+	}
+	return builder.data.get(i), true
+}
+
+// Length returns the number of values in the builder.
+func (builder *VectorBuilder[T]) Length() int {
+	return builder.data.count
+}
+
+// Set replaces the value at the specified index and returns true if the index is valid, or false otherwise.
+//
+//	builder := slice.NewVector[int](1, 2, 3).Builder()
+//	ok := builder.Set(1, 10)
+//	fmt.Println(builder.Vector().ToSlice(), ok) // &[1, 10, 3], true
+func (builder *VectorBuilder[T]) Set(i int, value T) bool {
+	if i < 0 || i >= builder.data.count {
+		return false
+	}
+	builder.data.set(builder.ensureEdit(), i, value)
+	return true
+}
+
+// Vector returns a persistent Vector with the current values of the builder.
+// The builder can keep being used; later changes copy the nodes they touch and do not affect the returned Vector.
+//
+//	builder := &slice.VectorBuilder[int]{}
+//	vector := builder.Append(1, 2).Vector()
+//	builder.Append(3)
+//	fmt.Println(vector.ToSlice()) // &[1, 2]
+func (builder *VectorBuilder[T]) Vector() *Vector[T] {
+	builder.edit = &vectorEdit{} // Nodes owned by the old edit are now shared with the returned Vector.
+	data := builder.data
+	data.tailEdit = nil
+	return &Vector[T]{data: data}
+}
+
+// ensureEdit returns the edit that owns the nodes the builder may change in place.
+func (builder *VectorBuilder[T]) ensureEdit() *vectorEdit {
+	if builder.edit == nil {
+		builder.edit = &vectorEdit{}
+	}
+	return builder.edit
+}
+
+// vectorEdit identifies the VectorBuilder that owns a node. It is not zero-sized so that every allocation has a distinct address.
+type vectorEdit struct {
+	_ byte
+}
+
+// vectorNode is a node of the trie. Branch nodes use children and leaf nodes use values.
+// A branch whose children are all full except the last is regular and finds a child by shifting the index.
+// Any other branch is relaxed and keeps the cumulative number of values below each child in sizes.
+type vectorNode[T any] struct {
+	edit     *vectorEdit
+	children []*vectorNode[T]
+	sizes    []int
+	values   []T
+}
+
+// vectorData holds the trie and the tail buffer that stores the last (up to 32) values.
+// The tail is only empty when the Vector is empty.
+type vectorData[T any] struct {
+	count    int
+	shift    int
+	root     *vectorNode[T]
+	tail     []T
+	tailEdit *vectorEdit
+}
+
+// all returns an iterator over the index-value pairs of the trie, one leaf at a time.
+func (data *vectorData[T]) all() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		if data.root != nil && !data.root.each(data.shift, &i, yield) {
+			return
+		}
+		for _, value := range data.tail {
+			if !yield(i, value) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// append adds a value to the end of the trie.
+func (data *vectorData[T]) append(edit *vectorEdit, value T) {
+	if len(data.tail) < vectorWidth {
+		data.ensureTail(edit)
+		data.tail = append(data.tail, value)
+		data.count++
+		return
+	}
+	// The full tail becomes a leaf owned by edit, so it must not share its array with another Vector.
+	data.ensureTail(edit)
+	data.pushLeaf(edit, &vectorNode[T]{edit: edit, values: data.tail})
+	data.tail = make([]T, 1, vectorWidth)
+	data.tail[0] = value
+	data.tailEdit = edit
+	data.count++
+}
+
+// concat appends the values of other to the trie. The tail of the trie becomes a leaf and the branches
+// along the seam between the two tries are rebalanced, which costs O(log32 n).
+func (data *vectorData[T]) concat(edit *vectorEdit, other vectorData[T]) {
+	if data.count == 0 {
+		*data = other
+		return
+	}
+	if other.root == nil {
+		for _, value := range other.tail {
+			data.append(edit, value)
+		}
+		return
+	}
+	data.pushLeaf(edit, &vectorNode[T]{edit: edit, values: slices.Clone(data.tail)})
+	other.root = data.root.concat(edit, data.shift, other.root, other.shift)
+	other.shift = max(data.shift, other.shift) + vectorBits
+	other.count += data.count
+	other.trim()
+	*data = other
+}
+
+// delete removes the value at index i, which must be in bounds, by joining the values before and after it.
+func (data *vectorData[T]) delete(edit *vectorEdit, i int) {
+	if i == data.count-1 {
+		data.pop(edit)
+		return
+	}
+	// Taking the values before i may change nodes owned by edit in place, so the values after i are dropped first.
+	after := *data
+	after.drop(edit, i+1)
+	data.take(edit, i)
+	data.concat(edit, after)
+}
+
+// drop removes the first n values of the trie.
+func (data *vectorData[T]) drop(edit *vectorEdit, n int) {
+	offset := data.tailOffset()
+	switch {
+	case n <= 0:
+		return
+	case n >= data.count:
+		*data = vectorData[T]{}
+		return
+	case n >= offset:
+		data.root, data.shift = nil, 0
+		data.tail = data.tail[n-offset:]
+		data.tailEdit = nil
+		data.ensureTail(edit)
+	default:
+		data.root = data.root.drop(edit, data.shift, n)
+		data.trim()
+	}
+	data.count -= n
+}
+
+// ensureTail makes the tail buffer safe to change in place.
+func (data *vectorData[T]) ensureTail(edit *vectorEdit) {
+	if edit == nil || data.tailEdit != edit {
+		tail := make([]T, len(data.tail), vectorWidth)
+		copy(tail, data.tail)
+		data.tail = tail
+		data.tailEdit = edit
+	}
+}
+
+// get returns the value at index i, which must be in bounds.
+func (data *vectorData[T]) get(i int) T {
+	offset := data.tailOffset()
+	if i >= offset {
+		return data.tail[i-offset]
+	}
+	node := data.root
+	for shift := data.shift; shift > 0; shift -= vectorBits {
+		var child int
+		child, i = node.child(shift, i)
+		node = node.children[child]
+	}
+	return node.values[i]
+}
+
+// pop removes the last value of the trie, which must not be empty.
+func (data *vectorData[T]) pop(edit *vectorEdit) {
+	if data.count == 1 {
+		*data = vectorData[T]{}
+		return
+	}
+	if len(data.tail) > 1 {
+		data.ensureTail(edit)
+		var zero T
+		data.tail[len(data.tail)-1] = zero
+		data.tail = data.tail[:len(data.tail)-1]
+		data.count--
+		return
+	}
+	var leaf *vectorNode[T]
+	data.root, leaf = data.root.popLeaf(edit, data.shift)
+	data.tail = leaf.values
+	data.tailEdit = nil // The tail is a leaf that may be shared.
+	data.count--
+	data.trim()
+}
+
+// pushLeaf adds a leaf after the last leaf of the trie, adding a level when the rightmost path is full.
+func (data *vectorData[T]) pushLeaf(edit *vectorEdit, leaf *vectorNode[T]) {
+	if data.root == nil {
+		data.root = newVectorBranch(edit, vectorBits, []*vectorNode[T]{leaf})
+		data.shift = vectorBits
+		return
+	}
+	if root := data.root.pushLeaf(edit, data.shift, leaf); root != nil {
+		data.root = root
+		return
+	}
+	path := newVectorPath(edit, data.shift, leaf)
+	data.root = newVectorBranch(edit, data.shift+vectorBits, []*vectorNode[T]{data.root, path})
+	data.shift += vectorBits
+}
+
+// set replaces the value at index i, which must be in bounds.
+func (data *vectorData[T]) set(edit *vectorEdit, i int, value T) {
+	if offset := data.tailOffset(); i >= offset {
+		data.ensureTail(edit)
+		data.tail[i-offset] = value
+		return
+	}
+	data.root = data.root.set(edit, data.shift, i, value)
+}
+
+// tailOffset returns the index of the first value held in the tail buffer.
+func (data *vectorData[T]) tailOffset() int {
+	return data.count - len(data.tail)
+}
+
+// take keeps the first n values of the trie and removes the rest.
+func (data *vectorData[T]) take(edit *vectorEdit, n int) {
+	offset := data.tailOffset()
+	switch {
+	case n >= data.count:
+		return
+	case n <= 0:
+		*data = vectorData[T]{}
+		return
+	case n > offset:
+		data.tail = data.tail[:n-offset]
+		data.tailEdit = nil
+		data.ensureTail(edit)
+	default:
+		var leaf *vectorNode[T]
+		data.root, leaf = data.root.take(edit, data.shift, n).popLeaf(edit, data.shift)
+		data.tail = leaf.values
+		data.tailEdit = nil // The tail is a leaf that may be shared.
+		data.trim()
+	}
+	data.count = n
+}
+
+// trim removes the branches with a single child from the top of the trie.
+func (data *vectorData[T]) trim() {
+	if data.root == nil {
+		data.shift = 0
+		return
+	}
+	for data.shift > vectorBits && len(data.root.children) == 1 {
+		data.root = data.root.children[0]
+		data.shift -= vectorBits
+	}
+}
+
+// child returns the index of the child of node, a branch at the given shift, that holds index i,
+// and the index of the value within that child.
+func (node *vectorNode[T]) child(shift int, i int) (int, int) {
+	child := i >> shift
+	if node.sizes == nil {
+		return child, i - child<<shift
+	}
+	// A child holds at most 1<<shift values, so the child that holds i is never to the left of i>>shift.
+	for node.sizes[child] <= i {
+		child++
+	}
+	if child > 0 {
+		i -= node.sizes[child-1]
+	}
+	return child, i
+}
+
+// concat joins left, a node at leftShift, and right, a node at rightShift, and returns a branch one level above the
+// higher of the two. The nodes along the seam are rebalanced so that the trie stays O(log32 n) deep.
+func (left *vectorNode[T]) concat(edit *vectorEdit, leftShift int, right *vectorNode[T], rightShift int) *vectorNode[T] {
+	shift := max(leftShift, rightShift)
+	if shift == 0 {
+		return newVectorBranch(edit, vectorBits, vectorRebalance(edit, 0, []*vectorNode[T]{left, right}))
+	}
+	var nodes []*vectorNode[T]
+	switch last := len(left.children) - 1; {
+	case leftShift > rightShift:
+		middle := left.children[last].concat(edit, leftShift-vectorBits, right, rightShift)
+		nodes = slices.Concat(left.children[:last], middle.children)
+	case leftShift < rightShift:
+		middle := left.concat(edit, leftShift, right.children[0], rightShift-vectorBits)
+		nodes = slices.Concat(middle.children, right.children[1:])
+	default:
+		middle := left.children[last].concat(edit, shift-vectorBits, right.children[0], shift-vectorBits)
+		nodes = slices.Concat(left.children[:last], middle.children, right.children[1:])
+	}
+	nodes = vectorRebalance(edit, shift-vectorBits, nodes)
+	var parents []*vectorNode[T]
+	for len(nodes) > 0 {
+		n := min(len(nodes), vectorWidth)
+		parents = append(parents, newVectorBranch(edit, shift, slices.Clone(nodes[:n])))
+		nodes = nodes[n:]
+	}
+	return newVectorBranch(edit, shift+vectorBits, parents)
+}
+
+// drop returns node, a node at the given shift, without its first n values. n must be less than the size of node.
+func (node *vectorNode[T]) drop(edit *vectorEdit, shift int, n int) *vectorNode[T] {
+	if n == 0 {
+		return node
+	}
+	if shift == 0 {
+		return &vectorNode[T]{edit: edit, values: slices.Clone(node.values[n:])}
+	}
+	child, i := node.child(shift, n)
+	children := make([]*vectorNode[T], len(node.children)-child)
+	children[0] = node.children[child].drop(edit, shift-vectorBits, i)
+	copy(children[1:], node.children[child+1:])
+	return newVectorBranch(edit, shift, children)
+}
+
+// each yields the values below node, a node at the given shift, numbering them from *i, and reports whether to continue.
+func (node *vectorNode[T]) each(shift int, i *int, yield func(int, T) bool) bool {
+	if shift == 0 {
+		for _, value := range node.values {
+			if !yield(*i, value) {
+				return false
+			}
+			*i++
+		}
+		return true
+	}
+	for _, child := range node.children {
+		if !child.each(shift-vectorBits, i, yield) {
+			return false
+		}
+	}
+	return true
+}
+
+// editable returns node if it is owned by edit, or a copy owned by edit otherwise.
+func (node *vectorNode[T]) editable(edit *vectorEdit) *vectorNode[T] {
+	if edit != nil && node.edit == edit {
+		return node
+	}
+	return &vectorNode[T]{
+		edit:     edit,
+		children: slices.Clone(node.children),
+		sizes:    slices.Clone(node.sizes),
+		values:   slices.Clone(node.values),
+	}
+}
+
+// popLeaf removes the last leaf below node, a branch at the given shift, and returns the new node and the leaf.
+// The new node is nil if node becomes empty.
+func (node *vectorNode[T]) popLeaf(edit *vectorEdit, shift int) (*vectorNode[T], *vectorNode[T]) {
+	last := len(node.children) - 1
+	leaf := node.children[last]
+	if shift > vectorBits {
+		var child *vectorNode[T]
+		child, leaf = leaf.popLeaf(edit, shift-vectorBits)
+		if child != nil {
+			newNode := node.editable(edit)
+			newNode.children[last] = child
+			if newNode.sizes != nil {
+				newNode.sizes[last] -= len(leaf.values)
+			}
+			return newNode, leaf
+		}
+	}
+	if last == 0 {
+		return nil, leaf
+	}
+	newNode := node.editable(edit)
+	newNode.children[last] = nil
+	newNode.children = newNode.children[:last]
+	if newNode.sizes != nil {
+		newNode.sizes = newNode.sizes[:last]
+	}
+	return newNode, leaf
+}
+
+// pushLeaf adds a leaf after the last leaf below node, a branch at the given shift, and returns the new node,
+// or nil if there is no room for the leaf.
+func (node *vectorNode[T]) pushLeaf(edit *vectorEdit, shift int, leaf *vectorNode[T]) *vectorNode[T] {
+	last := len(node.children) - 1
+	if shift > vectorBits {
+		if child := node.children[last].pushLeaf(edit, shift-vectorBits, leaf); child != nil {
+			newNode := node.editable(edit)
+			newNode.children[last] = child
+			if newNode.sizes != nil {
+				newNode.sizes[last] += len(leaf.values)
+			}
+			return newNode
+		}
+	}
+	if len(node.children) == vectorWidth {
+		return nil
+	}
+	newNode := node.editable(edit)
+	if newNode.sizes == nil && newNode.children[last].size(shift-vectorBits) != 1<<shift {
+		// The last child is not full and is about to get a sibling, so the branch becomes relaxed.
+		newNode.sizes = vectorSizes(newNode.children, shift)
+	}
+	newNode.children = append(newNode.children, newVectorPath(edit, shift-vectorBits, leaf))
+	if newNode.sizes != nil {
+		newNode.sizes = append(newNode.sizes, newNode.sizes[last]+len(leaf.values))
+	}
+	return newNode
+}
+
+// set replaces the value at index i below node, a node at the given shift, and returns the new node.
+func (node *vectorNode[T]) set(edit *vectorEdit, shift int, i int, value T) *vectorNode[T] {
+	newNode := node.editable(edit)
+	if shift == 0 {
+		newNode.values[i] = value
+		return newNode
+	}
+	child, j := node.child(shift, i)
+	newNode.children[child] = node.children[child].set(edit, shift-vectorBits, j, value)
+	return newNode
+}
+
+// size returns the number of values below node, a node at the given shift.
+func (node *vectorNode[T]) size(shift int) int {
+	if shift == 0 {
+		return len(node.values)
+	}
+	if node.sizes != nil {
+		return node.sizes[len(node.sizes)-1]
+	}
+	last := len(node.children) - 1
+	return last<<shift + node.children[last].size(shift-vectorBits)
+}
+
+// take returns the first n values below node, a node at the given shift. n must be at least 1.
+func (node *vectorNode[T]) take(edit *vectorEdit, shift int, n int) *vectorNode[T] {
+	if shift == 0 {
+		if n == len(node.values) {
+			return node
+		}
+		return &vectorNode[T]{edit: edit, values: slices.Clone(node.values[:n])}
+	}
+	child, i := node.child(shift, n-1)
+	newChild := node.children[child].take(edit, shift-vectorBits, i+1)
+	if child == len(node.children)-1 && newChild == node.children[child] {
+		return node
+	}
+	newNode := &vectorNode[T]{edit: edit, children: slices.Clone(node.children[:child+1])}
+	newNode.children[child] = newChild
+	if node.sizes != nil {
+		newNode.sizes = slices.Clone(node.sizes[:child+1])
+		newNode.sizes[child] = n
+	}
+	return newNode
+}
+
+// newVectorBranch returns a branch at the given shift owned by edit. The branch is relaxed unless all children
+// but the last are full.
+func newVectorBranch[T any](edit *vectorEdit, shift int, children []*vectorNode[T]) *vectorNode[T] {
+	node := &vectorNode[T]{edit: edit, children: children}
+	for _, child := range children[:len(children)-1] {
+		if child.size(shift-vectorBits) != 1<<shift {
+			node.sizes = vectorSizes(children, shift)
+			break
+		}
+	}
+	return node
+}
+
+// newVectorPath returns a chain of branch nodes from the given shift down to the given leaf.
+func newVectorPath[T any](edit *vectorEdit, shift int, leaf *vectorNode[T]) *vectorNode[T] {
+	if shift == 0 {
+		return leaf
+	}
+	return &vectorNode[T]{edit: edit, children: []*vectorNode[T]{newVectorPath(edit, shift-vectorBits, leaf)}}
+}
+
+// vectorRebalance spreads the children or values of nodes, which are at the given shift, over fewer nodes when there
+// are more than vectorExtra nodes beyond the fewest that could hold them, and returns the new nodes.
+// This is the concatenation plan of relaxed radix balanced trees; nodes whose contents do not move are reused.
+func vectorRebalance[T any](edit *vectorEdit, shift int, nodes []*vectorNode[T]) []*vectorNode[T] {
+	counts := make([]int, len(nodes))
+	total := 0
+	for i, node := range nodes {
+		counts[i] = len(node.children) + len(node.values)
+		total += counts[i]
+	}
+	n, optimal := len(counts), (total+vectorWidth-1)/vectorWidth
+	for i := 0; n > optimal+vectorExtra; i-- {
+		for counts[i] > vectorWidth-vectorExtra/2 {
+			i++
+		}
+		// Move the contents of the first node that is not nearly full into the nodes after it until one is emptied.
+		for remaining := counts[i]; remaining > 0; i++ {
+			merged := min(remaining+counts[i+1], vectorWidth)
+			remaining += counts[i+1] - merged
+			counts[i] = merged
+		}
+		copy(counts[i:n-1], counts[i+1:n])
+		n--
+	}
+	newNodes := make([]*vectorNode[T], 0, n)
+	k, offset := 0, 0
+	for _, count := range counts[:n] {
+		if offset == 0 && len(nodes[k].children)+len(nodes[k].values) == count {
+			newNodes = append(newNodes, nodes[k])
+			k++
+			continue
+		}
+		newNode := &vectorNode[T]{edit: edit}
+		for filled := 0; filled < count; {
+			node := nodes[k]
+			m := min(count-filled, len(node.children)+len(node.values)-offset)
+			if shift == 0 {
+				newNode.values = append(newNode.values, node.values[offset:offset+m]...)
+			} else {
+				newNode.children = append(newNode.children, node.children[offset:offset+m]...)
+			}
+			filled, offset = filled+m, offset+m
+			if offset == len(node.children)+len(node.values) {
+				k, offset = k+1, 0
+			}
+		}
+		if shift > 0 {
+			newNode = newVectorBranch(edit, shift, newNode.children)
+		}
+		newNodes = append(newNodes, newNode)
+	}
+	return newNodes
+}
+
+// vectorSizes returns the cumulative number of values below each of the children of a branch at the given shift.
+func vectorSizes[T any](children []*vectorNode[T], shift int) []int {
+	sizes := make([]int, len(children), vectorWidth)
+	total := 0
+	for i, child := range children {
+		total += child.size(shift - vectorBits)
+		sizes[i] = total
+	}
+	return sizes
+}
+
+// NewVector creates a new instance of the Vector[T] type and initializes it with the provided values.
+// Use NewVector(*s...) to create a Vector from a Slice.
+func NewVector[T any](values ...T) *Vector[T] {
+	return (&VectorBuilder[T]{}).Append(values...).Vector()
+}
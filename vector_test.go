@@ -0,0 +1,301 @@
+package slice_test
+
+import (
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/lindsaygelle/slice"
+)
+
+func TestVectorAppend(t *testing.T) {
+	// Test case 1: Append leaves the original Vector untouched.
+	v1 := slice.NewVector[int](1, 2)
+	v2 := v1.Append(3)
+	v3 := v1.Append(4, 5)
+
+	if result := v1.ToSlice(); !reflect.DeepEqual(result, &slice.Slice[int]{1, 2}) {
+		t.Errorf("Expected %v, but got %v", &slice.Slice[int]{1, 2}, result)
+	}
+	if result := v2.ToSlice(); !reflect.DeepEqual(result, &slice.Slice[int]{1, 2, 3}) {
+		t.Errorf("Expected %v, but got %v", &slice.Slice[int]{1, 2, 3}, result)
+	}
+	if result := v3.ToSlice(); !reflect.DeepEqual(result, &slice.Slice[int]{1, 2, 4, 5}) {
+		t.Errorf("Expected %v, but got %v", &slice.Slice[int]{1, 2, 4, 5}, result)
+	}
+
+	// Test case 2: Append across several trie levels.
+	v := &slice.Vector[int]{}
+	for i := 0; i < 40000; i++ {
+		v = v.Append(i)
+	}
+	if v.Length() != 40000 {
+		t.Fatalf("Expected length 40000, but got %d", v.Length())
+	}
+	for i := 0; i < 40000; i++ {
+		if value := v.Fetch(i); value != i {
+			t.Fatalf("Expected %d at index %d, but got %d", i, i, value)
+		}
+	}
+}
+
+func TestVectorDelete(t *testing.T) {
+	v1 := slice.NewVector[int](1, 2, 3, 4)
+
+	// Test case 1: Delete the last value.
+	if result := v1.Delete(3).ToSlice(); !reflect.DeepEqual(result, &slice.Slice[int]{1, 2, 3}) {
+		t.Errorf("Expected %v, but got %v", &slice.Slice[int]{1, 2, 3}, result)
+	}
+
+	// Test case 2: Delete a value in the middle.
+	if result := v1.Delete(1).ToSlice(); !reflect.DeepEqual(result, &slice.Slice[int]{1, 3, 4}) {
+		t.Errorf("Expected %v, but got %v", &slice.Slice[int]{1, 3, 4}, result)
+	}
+
+	// Test case 3: Delete out of bounds returns the receiver.
+	if result := v1.Delete(4); result != v1 {
+		t.Errorf("Expected the receiver, but got %v", result.ToSlice())
+	}
+
+	if result := v1.ToSlice(); !reflect.DeepEqual(result, &slice.Slice[int]{1, 2, 3, 4}) {
+		t.Errorf("Expected %v, but got %v", &slice.Slice[int]{1, 2, 3, 4}, result)
+	}
+
+	// Test case 4: Delete every value one at a time from the end.
+	values := make([]int, 1100)
+	for i := range values {
+		values[i] = i
+	}
+	v := slice.NewVector(values...)
+	for i := len(values) - 1; i >= 0; i-- {
+		v = v.Delete(i)
+		if v.Length() != i {
+			t.Fatalf("Expected length %d, but got %d", i, v.Length())
+		}
+		if i > 0 && v.Fetch(i-1) != i-1 {
+			t.Fatalf("Expected %d at index %d, but got %d", i-1, i-1, v.Fetch(i-1))
+		}
+	}
+	if !v.IsEmpty() {
+		t.Errorf("Expected an empty Vector, but got %v", v.ToSlice())
+	}
+
+	// Test case 5: Delete values from the front and the middle of a Vector with several trie levels.
+	values = make([]int, 40000)
+	for i := range values {
+		values[i] = i
+	}
+	v = slice.NewVector(values...)
+	model := slices.Clone(values)
+	for len(model) > 0 {
+		i := 0
+		if len(model)%2 == 0 {
+			i = len(model) / 2
+		}
+		v = v.Delete(i)
+		model = slices.Delete(model, i, i+1)
+		if len(model)%1000 == 0 && !slices.Equal([]int(*v.ToSlice()), model) {
+			t.Fatalf("Vector of length %d does not match the expected values", len(model))
+		}
+	}
+	if !v.IsEmpty() {
+		t.Errorf("Expected an empty Vector, but got %v", v.ToSlice())
+	}
+}
+
+func TestVectorGet(t *testing.T) {
+	v := slice.NewVector[int](1, 2, 3)
+
+	if value, ok := v.Get(1); !ok || value != 2 {
+		t.Errorf("Expected 2, true but got %d, %v", value, ok)
+	}
+	if _, ok := v.Get(3); ok {
+		t.Errorf("Expected false, but got true")
+	}
+	if _, ok := v.Get(-1); ok {
+		t.Errorf("Expected false, but got true")
+	}
+	if _, ok := (&slice.Vector[int]{}).Get(0); ok {
+		t.Errorf("Expected false, but got true")
+	}
+}
+
+func TestVectorSet(t *testing.T) {
+	values := make([]int, 100)
+	v1 := slice.NewVector(values...)
+
+	// Test case 1: Set values in the trie and in the tail.
+	v2 := v1.Set(5, 1).Set(99, 2)
+
+	if v1.Fetch(5) != 0 || v1.Fetch(99) != 0 {
+		t.Errorf("Expected the original Vector to be unchanged")
+	}
+	if v2.Fetch(5) != 1 || v2.Fetch(99) != 2 {
+		t.Errorf("Expected 1 and 2, but got %d and %d", v2.Fetch(5), v2.Fetch(99))
+	}
+
+	// Test case 2: Set out of bounds returns the receiver.
+	if result := v1.Set(100, 1); result != v1 {
+		t.Errorf("Expected the receiver, but got a new Vector")
+	}
+}
+
+func TestVectorSlice(t *testing.T) {
+	v := slice.NewVector[int](1, 2, 3, 4, 5)
+
+	// Test case 1: Slice a small Vector, swapping and clamping the indices.
+	tests := []struct {
+		i, j     int
+		expected *slice.Slice[int]
+	}{
+		{1, 3, &slice.Slice[int]{2, 3, 4}},
+		{0, 2, &slice.Slice[int]{1, 2, 3}},
+		{3, 1, &slice.Slice[int]{2, 3, 4}},
+		{-2, 10, &slice.Slice[int]{1, 2, 3, 4, 5}},
+		{6, 8, &slice.Slice[int]{}},
+	}
+	for _, test := range tests {
+		if result := v.Slice(test.i, test.j).ToSlice(); !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("Slice(%d, %d): expected %v, but got %v", test.i, test.j, test.expected, result)
+		}
+	}
+
+	if result := v.ToSlice(); !reflect.DeepEqual(result, &slice.Slice[int]{1, 2, 3, 4, 5}) {
+		t.Errorf("Expected %v, but got %v", &slice.Slice[int]{1, 2, 3, 4, 5}, result)
+	}
+
+	// Test case 2: Slice a Vector with several trie levels and keep changing the result.
+	values := make([]int, 40000)
+	for i := range values {
+		values[i] = i
+	}
+	v = slice.NewVector(values...)
+	for _, bounds := range [][2]int{{0, 39999}, {1, 39999}, {31, 32}, {1000, 33000}, {32768, 39999}, {5, 39990}} {
+		i, j := bounds[0], bounds[1]
+		subVector := v.Slice(i, j)
+		if result := []int(*subVector.ToSlice()); !slices.Equal(result, values[i:j+1]) {
+			t.Fatalf("Slice(%d, %d) does not match the expected values", i, j)
+		}
+		expected := append(slices.Clone(values[i+1:j+1]), -1)
+		if result := []int(*subVector.Delete(0).Append(-1).ToSlice()); !slices.Equal(result, expected) {
+			t.Fatalf("Slice(%d, %d) does not support later changes", i, j)
+		}
+	}
+}
+
+func TestVectorBuilder(t *testing.T) {
+	v1 := slice.NewVector[int](1, 2, 3)
+	builder := v1.Builder()
+
+	// Test case 1: Builder changes do not affect the source Vector.
+	builder.Append(4).Set(0, 10)
+	builder.Delete(1)
+
+	if result := v1.ToSlice(); !reflect.DeepEqual(result, &slice.Slice[int]{1, 2, 3}) {
+		t.Errorf("Expected %v, but got %v", &slice.Slice[int]{1, 2, 3}, result)
+	}
+
+	// Test case 2: Changes after Vector do not affect the returned Vector.
+	v2 := builder.Vector()
+	builder.Set(0, 20)
+	builder.Append(5)
+
+	if result := v2.ToSlice(); !reflect.DeepEqual(result, &slice.Slice[int]{10, 3, 4}) {
+		t.Errorf("Expected %v, but got %v", &slice.Slice[int]{10, 3, 4}, result)
+	}
+	if result := builder.Vector().ToSlice(); !reflect.DeepEqual(result, &slice.Slice[int]{20, 3, 4, 5}) {
+		t.Errorf("Expected %v, but got %v", &slice.Slice[int]{20, 3, 4, 5}, result)
+	}
+
+	// Test case 3: Out of bounds operations report false.
+	if builder.Set(10, 1) || builder.Delete(-1) {
+		t.Errorf("Expected false, but got true")
+	}
+}
+
+func TestVectorPersistence(t *testing.T) {
+	// Compare a chain of persistent versions against plain slices.
+	random := rand.New(rand.NewSource(1))
+	var versions []*slice.Vector[int]
+	var expected [][]int
+	v := &slice.Vector[int]{}
+	var model []int
+	for step := 0; step < 3000; step++ {
+		switch operation := random.Intn(10); {
+		case operation < 6 || len(model) == 0:
+			value := random.Int()
+			v = v.Append(value)
+			model = append(model[:len(model):len(model)], value)
+		case operation < 8:
+			i, value := random.Intn(len(model)), random.Int()
+			v = v.Set(i, value)
+			model = append([]int(nil), model...)
+			model[i] = value
+		case operation < 9 || len(model) < 100:
+			i := random.Intn(len(model))
+			v = v.Delete(i)
+			model = slices.Delete(slices.Clone(model), i, i+1)
+		default:
+			i, j := random.Intn(len(model)/4), len(model)-1-random.Intn(len(model)/4)
+			v = v.Slice(i, j)
+			model = slices.Clone(model[i : j+1])
+		}
+		if step%100 == 0 {
+			versions = append(versions, v)
+			expected = append(expected, model)
+		}
+	}
+	for i, version := range versions {
+		if result := []int(*version.ToSlice()); !slices.Equal(result, expected[i]) {
+			t.Fatalf("Version %d does not match its snapshot", i)
+		}
+	}
+}
+
+func TestVectorBuilderPersistence(t *testing.T) {
+	// Test case 1: Filling the tail of a builder does not change the source Vector.
+	values := make([]int, 32)
+	v := slice.NewVector(values...)
+	builder := v.Builder()
+	builder.Append(32)
+	builder.Set(0, 100)
+	if v.Fetch(0) != 0 {
+		t.Errorf("Expected 0, but got %d", v.Fetch(0))
+	}
+
+	// Test case 2: Compare the Vectors returned by a chain of builders against plain slices.
+	random := rand.New(rand.NewSource(1))
+	var versions []*slice.Vector[int]
+	var expected [][]int
+	builder = (&slice.Vector[int]{}).Builder()
+	var model []int
+	for step := 0; step < 3000; step++ {
+		switch operation := random.Intn(10); {
+		case operation < 6 || len(model) == 0:
+			value := random.Int()
+			builder.Append(value)
+			model = append(model, value)
+		case operation < 8:
+			i, value := random.Intn(len(model)), random.Int()
+			builder.Set(i, value)
+			model[i] = value
+		default:
+			i := random.Intn(len(model))
+			builder.Delete(i)
+			model = append(model[:i], model[i+1:]...)
+		}
+		if step%50 == 0 {
+			versions = append(versions, builder.Vector())
+			expected = append(expected, slices.Clone(model))
+		}
+		if step%200 == 0 {
+			builder = versions[len(versions)-1].Builder() // Continue from a Vector that is already shared.
+		}
+	}
+	for i, version := range versions {
+		if result := []int(*version.ToSlice()); !slices.Equal(result, expected[i]) {
+			t.Fatalf("Version %d does not match its snapshot", i)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package slice
+
+// Chunk divides the slice into consecutive chunks of n elements and returns them in order. The last chunk holds
+// the remaining elements and may be shorter. The returned slice is empty if n is less than 1.
+// Chunk is a function rather than a method because a method of Slice[T] cannot return a *Slice[*Slice[T]].
+//
+// Each chunk shares memory with the slice, so replacing an element of a chunk changes the slice. The capacity of each
+// chunk is limited to its length, so appending to a chunk copies it instead of overwriting the next chunk.
+//
+//	newSlice := &slice.Slice[int]{1, 2, 3, 4, 5}
+//	chunks := slice.Chunk(newSlice, 2)
+//	fmt.Println(chunks.Fetch(0), chunks.Fetch(1), chunks.Fetch(2)) // &[1, 2], &[3, 4], &[5]
+func Chunk[T any](slice *Slice[T], n int) *Slice[*Slice[T]] {
+	chunks := Slice[*Slice[T]]{}
+	if n < 1 {
+		return &chunks
+	}
+	for i := 0; i < len(*slice); i += n {
+		end := min(i+n, len(*slice))
+		chunk := (*slice)[i:end:end]
+		chunks = append(chunks, &chunk)
+	}
+	return &chunks
+}
+
+// Windows returns the windows of size consecutive elements that start every step elements. Only full windows are
+// returned. A step smaller than size gives overlapping sliding windows, a step equal to size gives tumbling windows
+// and a larger step skips elements. The returned slice is empty if size or step is less than 1.
+//
+// Each window shares memory with the slice and has its capacity limited to its length, like the chunks of Chunk.
+//
+//	newSlice := &slice.Slice[int]{1, 2, 3, 4}
+//	windows := slice.Windows(newSlice, 3, 1)
+//	fmt.Println(windows.Fetch(0), windows.Fetch(1)) // &[1, 2, 3], &[2, 3, 4]
+func Windows[T any](slice *Slice[T], size int, step int) *Slice[*Slice[T]] {
+	windows := Slice[*Slice[T]]{}
+	if size < 1 || step < 1 {
+		return &windows
+	}
+	for i := 0; i+size <= len(*slice); i += step {
+		window := (*slice)[i : i+size : i+size]
+		windows = append(windows, &window)
+	}
+	return &windows
+}
+
+// SlidingReduce applies the given function to each window of size consecutive elements, like Reduce, and returns
+// a new slice with one result per window. The index passed to the function is the index of the element in the slice.
+// The returned slice is empty if size is less than 1 or greater than the length of the slice.
+// It runs in O(n·size) time; NumericSlice.MovingSum computes sums in O(n).
+//
+//	newSlice := &slice.Slice[int]{1, 3, 2, 5}
+//	result := newSlice.SlidingReduce(2, func(i int, currentValue int, resultValue int) int {
+//	    return max(currentValue, resultValue)
+//	})
+//	fmt.Println(result) // &[3, 3, 5]
+func (slice *Slice[T]) SlidingReduce(size int, fn func(i int, currentValue T, resultValue T) T) *Slice[T] {
+	newSlice := Slice[T]{}
+	if size < 1 {
+		return &newSlice
+	}
+	for start := 0; start+size <= len(*slice); start++ {
+		var resultValue T
+		for i := start; i < start+size; i++ {
+			resultValue = fn(i, (*slice)[i], resultValue)
+		}
+		newSlice = append(newSlice, resultValue)
+	}
+	return &newSlice
+}
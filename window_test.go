@@ -0,0 +1,68 @@
+package slice_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/lindsaygelle/slice"
+)
+
+func TestChunk(t *testing.T) {
+	newSlice := &slice.Slice[int]{1, 2, 3, 4, 5}
+
+	// Test case 1: The last chunk holds the remainder.
+	chunks := slice.Chunk(newSlice, 2)
+	expected := &slice.Slice[*slice.Slice[int]]{{1, 2}, {3, 4}, {5}}
+	if !reflect.DeepEqual(chunks, expected) {
+		t.Errorf("Expected %v, but got %v", expected, chunks)
+	}
+
+	// Test case 2: Chunks share memory, but appending to one does not overwrite the next.
+	chunks.Fetch(0).Replace(0, 10)
+	chunks.Fetch(0).Append(20)
+	expectedSlice := &slice.Slice[int]{10, 2, 3, 4, 5}
+	if !reflect.DeepEqual(newSlice, expectedSlice) {
+		t.Errorf("Expected %v, but got %v", expectedSlice, newSlice)
+	}
+
+	// Test case 3: Invalid sizes.
+	if result := slice.Chunk(newSlice, 0); result.Length() != 0 {
+		t.Errorf("Expected no chunks, but got %v", result)
+	}
+	if result := slice.Chunk(&slice.Slice[int]{}, 3); result.Length() != 0 {
+		t.Errorf("Expected no chunks, but got %v", result)
+	}
+}
+
+func TestWindows(t *testing.T) {
+	newSlice := &slice.Slice[int]{1, 2, 3, 4, 5}
+
+	tests := []struct {
+		size, step int
+		expected   *slice.Slice[*slice.Slice[int]]
+	}{
+		{3, 1, &slice.Slice[*slice.Slice[int]]{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}},
+		{2, 2, &slice.Slice[*slice.Slice[int]]{{1, 2}, {3, 4}}},
+		{1, 3, &slice.Slice[*slice.Slice[int]]{{1}, {4}}},
+		{6, 1, &slice.Slice[*slice.Slice[int]]{}},
+		{0, 1, &slice.Slice[*slice.Slice[int]]{}},
+		{2, 0, &slice.Slice[*slice.Slice[int]]{}},
+	}
+	for _, test := range tests {
+		if result := slice.Windows(newSlice, test.size, test.step); !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("Windows(%d, %d): expected %v, but got %v", test.size, test.step, test.expected, result)
+		}
+	}
+}
+
+func TestSlidingReduce(t *testing.T) {
+	newSlice := &slice.Slice[int]{1, 3, 2, 5}
+	result := newSlice.SlidingReduce(2, func(i int, currentValue int, resultValue int) int {
+		return max(currentValue, resultValue)
+	})
+
+	expected := &slice.Slice[int]{3, 3, 5}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+}
@@ -0,0 +1,110 @@
+package slice
+
+// Pair holds two values of possibly different types. It is the element type of Zip and ZipLongest.
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// Triple holds three values of possibly different types. It is the element type of Zip3.
+type Triple[A any, B any, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// Unzip splits a slice of pairs into a slice of the first values and a slice of the second values.
+//
+//	pairs := &slice.Slice[slice.Pair[int, string]]{{First: 1, Second: "a"}, {First: 2, Second: "b"}}
+//	numbers, letters := slice.Unzip(pairs)
+//	fmt.Println(numbers, letters) // &[1, 2], &[a, b]
+func Unzip[A any, B any](slice *Slice[Pair[A, B]]) (*Slice[A], *Slice[B]) {
+	first := make(Slice[A], len(*slice))
+	second := make(Slice[B], len(*slice))
+	for i, pair := range *slice {
+		first[i], second[i] = pair.First, pair.Second
+	}
+	return &first, &second
+}
+
+// Unzip3 splits a slice of triples into three slices, one for each field.
+//
+//	triples := &slice.Slice[slice.Triple[int, string, bool]]{{First: 1, Second: "a", Third: true}}
+//	numbers, letters, flags := slice.Unzip3(triples)
+//	fmt.Println(numbers, letters, flags) // &[1], &[a], &[true]
+func Unzip3[A any, B any, C any](slice *Slice[Triple[A, B, C]]) (*Slice[A], *Slice[B], *Slice[C]) {
+	first := make(Slice[A], len(*slice))
+	second := make(Slice[B], len(*slice))
+	third := make(Slice[C], len(*slice))
+	for i, triple := range *slice {
+		first[i], second[i], third[i] = triple.First, triple.Second, triple.Third
+	}
+	return &first, &second, &third
+}
+
+// Zip returns a slice of pairs holding the elements of slices a and b at the same index.
+// The result is as long as the shorter slice; use ZipLongest to keep the remaining elements of the longer one.
+//
+//	a := &slice.Slice[int]{1, 2, 3}
+//	b := &slice.Slice[string]{"a", "b"}
+//	pairs := slice.Zip(a, b)
+//	fmt.Println(pairs) // &[{1 a}, {2 b}]
+func Zip[A any, B any](a *Slice[A], b *Slice[B]) *Slice[Pair[A, B]] {
+	return ZipWith(a, b, func(i int, first A, second B) Pair[A, B] {
+		return Pair[A, B]{First: first, Second: second}
+	})
+}
+
+// Zip3 returns a slice of triples holding the elements of slices a, b and c at the same index.
+// The result is as long as the shortest slice.
+//
+//	a := &slice.Slice[int]{1, 2}
+//	b := &slice.Slice[string]{"a", "b"}
+//	c := &slice.Slice[bool]{true, false}
+//	triples := slice.Zip3(a, b, c)
+//	fmt.Println(triples) // &[{1 a true}, {2 b false}]
+func Zip3[A any, B any, C any](a *Slice[A], b *Slice[B], c *Slice[C]) *Slice[Triple[A, B, C]] {
+	newSlice := make(Slice[Triple[A, B, C]], min(len(*a), len(*b), len(*c)))
+	for i := range newSlice {
+		newSlice[i] = Triple[A, B, C]{First: (*a)[i], Second: (*b)[i], Third: (*c)[i]}
+	}
+	return &newSlice
+}
+
+// ZipLongest is like Zip but the result is as long as the longer slice.
+// Missing elements of the shorter slice are replaced by fillA or fillB.
+//
+//	a := &slice.Slice[int]{1, 2, 3}
+//	b := &slice.Slice[string]{"a"}
+//	pairs := slice.ZipLongest(a, b, 0, "-")
+//	fmt.Println(pairs) // &[{1 a}, {2 -}, {3 -}]
+func ZipLongest[A any, B any](a *Slice[A], b *Slice[B], fillA A, fillB B) *Slice[Pair[A, B]] {
+	newSlice := make(Slice[Pair[A, B]], max(len(*a), len(*b)))
+	for i := range newSlice {
+		newSlice[i] = Pair[A, B]{First: fillA, Second: fillB}
+		if i < len(*a) {
+			newSlice[i].First = (*a)[i]
+		}
+		if i < len(*b) {
+			newSlice[i].Second = (*b)[i]
+		}
+	}
+	return &newSlice
+}
+
+// ZipWith applies the given function to the elements of slices a and b at the same index and returns a new slice
+// with the results. The result is as long as the shorter slice.
+//
+//	a := &slice.Slice[int]{1, 2, 3}
+//	b := &slice.Slice[int]{10, 20, 30}
+//	sums := slice.ZipWith(a, b, func(i int, first int, second int) int {
+//	    return first + second
+//	})
+//	fmt.Println(sums) // &[11, 22, 33]
+func ZipWith[A any, B any, U any](a *Slice[A], b *Slice[B], fn func(i int, first A, second B) U) *Slice[U] {
+	newSlice := make(Slice[U], min(len(*a), len(*b)))
+	for i := range newSlice {
+		newSlice[i] = fn(i, (*a)[i], (*b)[i])
+	}
+	return &newSlice
+}
@@ -0,0 +1,103 @@
+package slice_test
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/lindsaygelle/slice"
+)
+
+func TestZip(t *testing.T) {
+	// Test case 1: Zip slices of different types and lengths.
+	a := &slice.Slice[int]{1, 2, 3}
+	b := &slice.Slice[string]{"a", "b"}
+	result := slice.Zip(a, b)
+
+	expected := &slice.Slice[slice.Pair[int, string]]{{First: 1, Second: "a"}, {First: 2, Second: "b"}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+
+	// Test case 2: Zip with an empty slice.
+	result = slice.Zip(a, &slice.Slice[string]{})
+	if result.Length() != 0 {
+		t.Errorf("Expected an empty slice, but got %v", result)
+	}
+}
+
+func TestZip3(t *testing.T) {
+	a := &slice.Slice[int]{1, 2, 3}
+	b := &slice.Slice[string]{"a", "b", "c"}
+	c := &slice.Slice[bool]{true, false}
+	result := slice.Zip3(a, b, c)
+
+	expected := &slice.Slice[slice.Triple[int, string, bool]]{
+		{First: 1, Second: "a", Third: true},
+		{First: 2, Second: "b", Third: false},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+}
+
+func TestZipLongest(t *testing.T) {
+	testCases := []struct {
+		a        *slice.Slice[int]
+		b        *slice.Slice[string]
+		expected *slice.Slice[slice.Pair[int, string]]
+	}{
+		// Test case 1: The first slice is longer.
+		{&slice.Slice[int]{1, 2, 3}, &slice.Slice[string]{"a"}, &slice.Slice[slice.Pair[int, string]]{{1, "a"}, {2, "-"}, {3, "-"}}},
+		// Test case 2: The second slice is longer.
+		{&slice.Slice[int]{1}, &slice.Slice[string]{"a", "b"}, &slice.Slice[slice.Pair[int, string]]{{1, "a"}, {-1, "b"}}},
+		// Test case 3: Both slices are empty.
+		{&slice.Slice[int]{}, &slice.Slice[string]{}, &slice.Slice[slice.Pair[int, string]]{}},
+	}
+
+	for i, test := range testCases {
+		result := slice.ZipLongest(test.a, test.b, -1, "-")
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("Test case %d: Expected %v, but got %v", i+1, test.expected, result)
+		}
+	}
+}
+
+func TestZipWith(t *testing.T) {
+	a := &slice.Slice[int]{1, 2, 3}
+	b := &slice.Slice[string]{"x", "y", "z", "w"}
+	result := slice.ZipWith(a, b, func(i int, first int, second string) string {
+		return strconv.Itoa(i) + second + strconv.Itoa(first)
+	})
+
+	expected := &slice.Slice[string]{"0x1", "1y2", "2z3"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+}
+
+func TestUnzip(t *testing.T) {
+	// Test case 1: Unzip reverses Zip.
+	a := &slice.Slice[int]{1, 2}
+	b := &slice.Slice[string]{"a", "b"}
+	first, second := slice.Unzip(slice.Zip(a, b))
+	if !reflect.DeepEqual(first, a) || !reflect.DeepEqual(second, b) {
+		t.Errorf("Expected %v and %v, but got %v and %v", a, b, first, second)
+	}
+
+	// Test case 2: Unzip an empty slice.
+	first, second = slice.Unzip(&slice.Slice[slice.Pair[int, string]]{})
+	if first.Length() != 0 || second.Length() != 0 {
+		t.Errorf("Expected empty slices, but got %v and %v", first, second)
+	}
+}
+
+func TestUnzip3(t *testing.T) {
+	a := &slice.Slice[int]{1, 2}
+	b := &slice.Slice[string]{"a", "b"}
+	c := &slice.Slice[bool]{true, false}
+	first, second, third := slice.Unzip3(slice.Zip3(a, b, c))
+	if !reflect.DeepEqual(first, a) || !reflect.DeepEqual(second, b) || !reflect.DeepEqual(third, c) {
+		t.Errorf("Expected %v, %v and %v, but got %v, %v and %v", a, b, c, first, second, third)
+	}
+}